@@ -0,0 +1,590 @@
+package geojson
+
+import (
+	"math"
+)
+
+// CutAntimeridian returns a copy of the geometry split at the antimeridian as recommended
+// by RFC 7946 section 3.1.9. A line string crossing the antimeridian becomes a multi-line string
+// and a polygon becomes a multi-polygon, with the crossing positions interpolated.
+// Multi geometries and the members of geometry collections are cut recursively.
+// Longitudes are normalized to [-180, 180], so unwrapped input, as returned by
+// UnwrapLongitudes, is accepted. A polygon may be cut into several polygons on each side
+// and the rings of cut polygons follow the RFC 7946 winding order. Paths with NaN or
+// infinite longitudes are not cut. A bbox is recomputed to match the result.
+// Polygons that contain a pole are not supported.
+func CutAntimeridian(g *Geometry) *Geometry {
+	if g == nil {
+		return nil
+	}
+
+	c := *g
+	c.Point, c.MultiPoint, c.LineString, c.MultiLineString = nil, nil, nil, nil
+	c.Polygon, c.MultiPolygon, c.Geometries = nil, nil, nil
+
+	switch g.Type {
+	case GeometryPoint:
+		c.Point = normalizePosition(g.Point)
+	case GeometryMultiPoint:
+		if g.MultiPoint != nil {
+			c.MultiPoint = make([][]float64, len(g.MultiPoint))
+			for i, p := range g.MultiPoint {
+				c.MultiPoint[i] = normalizePosition(p)
+			}
+		}
+	case GeometryLineString:
+		lines := cutLineString(g.LineString)
+		if len(lines) > 1 {
+			c.Type = GeometryMultiLineString
+			c.MultiLineString = lines
+		} else {
+			c.LineString = g.LineString
+			if len(lines) == 1 {
+				c.LineString = lines[0]
+			}
+		}
+	case GeometryMultiLineString:
+		if g.MultiLineString != nil {
+			c.MultiLineString = [][][]float64{}
+			for _, ls := range g.MultiLineString {
+				c.MultiLineString = append(c.MultiLineString, cutLineString(ls)...)
+			}
+		}
+	case GeometryPolygon:
+		polygons := cutPolygon(g.Polygon)
+		if len(polygons) > 1 {
+			c.Type = GeometryMultiPolygon
+			c.MultiPolygon = polygons
+		} else {
+			c.Polygon = g.Polygon
+			if len(polygons) == 1 {
+				c.Polygon = polygons[0]
+			}
+		}
+	case GeometryMultiPolygon:
+		if g.MultiPolygon != nil {
+			c.MultiPolygon = [][][][]float64{}
+			for _, p := range g.MultiPolygon {
+				c.MultiPolygon = append(c.MultiPolygon, cutPolygon(p)...)
+			}
+		}
+	case GeometryCollection:
+		if g.Geometries != nil {
+			c.Geometries = make([]*Geometry, len(g.Geometries))
+			for i, child := range g.Geometries {
+				c.Geometries[i] = CutAntimeridian(child)
+			}
+		}
+	}
+
+	if g.BoundingBox != nil {
+		c.BoundingBox = cutBoundingBox(g)
+	}
+
+	return &c
+}
+
+// cutBoundingBox returns the bbox of the geometry with normalized longitudes,
+// crossing the antimeridian if the geometry does.
+func cutBoundingBox(g *Geometry) []float64 {
+	bb := UnwrapLongitudes(g).ComputeBoundingBox()
+
+	d := BoundingBox(bb).Dimension()
+	if d == 0 {
+		return bb
+	}
+
+	if bb[d]-bb[0] >= 360 {
+		bb[0], bb[d] = -180, 180
+	} else {
+		bb[0], bb[d] = wrapLongitude(bb[0]), wrapLongitude(bb[d])
+	}
+
+	return bb
+}
+
+// UnwrapLongitudes returns a copy of the geometry with the longitudes shifted by multiples
+// of 360 so that consecutive positions are never more than 180 degrees apart.
+// This makes geometries crossing the antimeridian continuous, with longitudes outside
+// of [-180, 180], which simplifies computations such as length and area.
+// The parts of multi geometries are shifted to follow on from the previous part
+// and the rings of a polygon to be next to its exterior ring.
+func UnwrapLongitudes(g *Geometry) *Geometry {
+	if g == nil {
+		return nil
+	}
+
+	c := *g
+	switch g.Type {
+	case GeometryLineString:
+		c.LineString = unwrapPath(g.LineString, firstLongitude(g.LineString))
+	case GeometryMultiLineString:
+		if g.MultiLineString != nil {
+			c.MultiLineString = make([][][]float64, len(g.MultiLineString))
+			ref := math.NaN()
+			for i, ls := range g.MultiLineString {
+				if math.IsNaN(ref) {
+					ref = firstLongitude(ls)
+				}
+
+				c.MultiLineString[i] = unwrapPath(ls, ref)
+				if n := len(c.MultiLineString[i]); n != 0 {
+					ref = c.MultiLineString[i][n-1][0]
+				}
+			}
+		}
+	case GeometryPolygon:
+		c.Polygon = unwrapPolygon(g.Polygon, math.NaN())
+	case GeometryMultiPolygon:
+		if g.MultiPolygon != nil {
+			c.MultiPolygon = make([][][][]float64, len(g.MultiPolygon))
+			ref := math.NaN()
+			for i, p := range g.MultiPolygon {
+				c.MultiPolygon[i] = unwrapPolygon(p, ref)
+				if len(c.MultiPolygon[i]) != 0 && len(c.MultiPolygon[i][0]) != 0 {
+					ref = c.MultiPolygon[i][0][0][0]
+				}
+			}
+		}
+	case GeometryCollection:
+		if g.Geometries != nil {
+			c.Geometries = make([]*Geometry, len(g.Geometries))
+			for i, child := range g.Geometries {
+				c.Geometries[i] = UnwrapLongitudes(child)
+			}
+		}
+	}
+
+	return &c
+}
+
+// unwrapPath returns a copy of the path shifted so that its first longitude is within
+// 180 degrees of ref and consecutive longitudes are within 180 degrees of each other.
+func unwrapPath(path [][]float64, ref float64) [][]float64 {
+	if path == nil {
+		return nil
+	}
+
+	result := make([][]float64, len(path))
+	prev := ref
+	for i, p := range path {
+		if len(p) == 0 {
+			result[i] = p
+			continue
+		}
+
+		x := p[0]
+		if math.IsNaN(x) || math.IsInf(x, 0) {
+			// cannot be shifted and is not used as the reference for the next position
+			result[i] = p
+			continue
+		}
+
+		if !math.IsNaN(prev) {
+			x += 360 * math.Round((prev-x)/360)
+		}
+
+		result[i] = append([]float64{x}, p[1:]...)
+		prev = x
+	}
+
+	return result
+}
+
+// unwrapPolygon unwraps the rings of the polygon, shifting the exterior ring to be
+// next to ref, if set, and the interior rings to be next to the exterior ring.
+func unwrapPolygon(polygon [][][]float64, ref float64) [][][]float64 {
+	if polygon == nil {
+		return nil
+	}
+
+	result := make([][][]float64, len(polygon))
+	for i, ring := range polygon {
+		if math.IsNaN(ref) {
+			ref = firstLongitude(ring)
+		}
+
+		result[i] = unwrapPath(ring, ref)
+		if i == 0 && len(result[0]) != 0 {
+			ref = result[0][0][0]
+		}
+	}
+
+	return result
+}
+
+// cutLineString splits the line string where it crosses the antimeridian.
+// A line string that does not cross is returned with its longitudes normalized
+// and is otherwise unchanged.
+func cutLineString(ls [][]float64) [][][]float64 {
+	if !finiteLongitudes(ls) {
+		return [][][]float64{ls}
+	}
+
+	path := unwrapPath(ls, math.NaN())
+
+	var lines [][][]float64
+	var current [][]float64
+	prev, split := -1, false
+	for i, p := range path {
+		if len(p) == 0 {
+			current = append(current, p)
+			continue
+		}
+
+		if prev >= 0 {
+			a, b := path[prev], p
+			ca, cb := longitudeCell(a[0]), longitudeCell(b[0])
+
+			// add the crossing positions for each antimeridian between the two positions
+			for ca != cb {
+				step := 1
+				if cb < ca {
+					step = -1
+				}
+
+				boundary := 180 + 360*float64(ca)
+				if step < 0 {
+					boundary -= 360
+				}
+
+				crossing := interpolate(a, b, boundary)
+				lines = appendLine(lines, appendPosition(current, shiftPosition(crossing, ca)))
+
+				ca += step
+				current = [][]float64{shiftPosition(crossing, ca)}
+				split = true
+			}
+		}
+
+		// positions are only dropped where the line is split, when on the antimeridian
+		if q := shiftPosition(p, longitudeCell(p[0])); split {
+			current = appendPosition(current, q)
+			split = false
+		} else {
+			current = append(current, q)
+		}
+		prev = i
+	}
+
+	if lines == nil {
+		return [][][]float64{current}
+	}
+
+	return appendLine(lines, current)
+}
+
+// cutPolygon splits the polygon into the parts on either side of the antimeridian.
+func cutPolygon(polygon [][][]float64) [][][][]float64 {
+	for _, ring := range polygon {
+		if !finiteLongitudes(ring) {
+			return [][][][]float64{polygon}
+		}
+	}
+
+	rings := unwrapPolygon(polygon, math.NaN())
+	if len(rings) == 0 || len(rings[0]) == 0 {
+		return nil
+	}
+
+	// shift so that the western most position of the exterior ring is in [-180, 180)
+	west, east := math.Inf(1), math.Inf(-1)
+	for _, p := range rings[0] {
+		if len(p) != 0 {
+			west = math.Min(west, p[0])
+			east = math.Max(east, p[0])
+		}
+	}
+
+	k := longitudeCell(west)
+	if west-360*float64(k) == 180 {
+		// a ring starting exactly on the antimeridian is east of it
+		k++
+	}
+
+	for i := range rings {
+		for j := range rings[i] {
+			rings[i][j] = shiftPosition(rings[i][j], k)
+		}
+	}
+
+	if east-360*float64(k) <= 180 {
+		return [][][][]float64{rings}
+	}
+
+	// clipping relies on the interior always being to the left of the rings
+	for i, ring := range rings {
+		rings[i] = closeRing(ring)
+	}
+	rewindPolygon(rings, WindingRFC7946)
+
+	polygons := clipPolygon(rings, 180, true)
+	for _, p := range clipPolygon(rings, 180, false) {
+		for _, ring := range p {
+			for j := range ring {
+				ring[j] = shiftPosition(ring[j], 1)
+			}
+		}
+		polygons = append(polygons, p)
+	}
+
+	return polygons
+}
+
+// clipPolygon returns the parts of the polygon west, or east, of the longitude x.
+// The rings must follow the RFC 7946 winding order so the interior is always to the left.
+// The pieces of the rings on the kept side start and end on the meridian and are joined
+// by walking along it, north on the west side and south on the east side, to the start
+// of the next piece. A concave polygon can result in several polygons.
+func clipPolygon(rings [][][]float64, x float64, west bool) [][][][]float64 {
+	// side is 1 for positions on the kept side, 0 on the meridian and -1 otherwise
+	side := func(p []float64) int {
+		switch {
+		case p[0] == x:
+			return 0
+		case (p[0] < x) == west:
+			return 1
+		}
+		return -1
+	}
+
+	// along orders the positions on the meridian in the direction it is walked
+	along := func(p []float64) float64 {
+		if west {
+			return p[1]
+		}
+		return -p[1]
+	}
+
+	var pieces, exteriors, holes [][][]float64
+	for i, ring := range rings {
+		parts, inside := splitRing(ring, x, side)
+		switch {
+		case inside && i == 0:
+			exteriors = append(exteriors, ring)
+		case inside:
+			holes = append(holes, ring)
+		}
+		pieces = append(pieces, parts...)
+	}
+
+	used := make([]bool, len(pieces))
+	for i := range pieces {
+		if used[i] {
+			continue
+		}
+
+		var ring [][]float64
+		for j := i; j >= 0 && !used[j]; j = nextPiece(pieces, pieces[j][len(pieces[j])-1], along) {
+			used[j] = true
+
+			// consecutive pieces share the position on the meridian
+			piece := pieces[j]
+			if len(ring) != 0 && equalPositions(ring[len(ring)-1], piece[0]) {
+				piece = piece[1:]
+			}
+			ring = append(ring, piece...)
+		}
+
+		if ring = closeRing(ring); len(ring) >= 4 {
+			exteriors = append(exteriors, ring)
+		}
+	}
+
+	polygons := make([][][][]float64, len(exteriors))
+	for i, e := range exteriors {
+		polygons[i] = [][][]float64{e}
+	}
+
+	// holes not crossing the meridian belong to the polygon they are within
+	for _, h := range holes {
+		for i, e := range exteriors {
+			if pointInRing(h[0], e) >= 0 {
+				polygons[i] = append(polygons[i], h)
+				break
+			}
+		}
+	}
+
+	return polygons
+}
+
+// splitRing returns the pieces of the closed ring on the kept side of the meridian at x,
+// each starting and ending on the meridian. Inside is true if the ring does not cross
+// the meridian and is on the kept side, in which case there are no pieces.
+func splitRing(ring [][]float64, x float64, side func([]float64) int) ([][][]float64, bool) {
+	n := len(ring) - 1
+	start, kept := -1, false
+	for i := 0; i < n; i++ {
+		switch side(ring[i]) {
+		case -1:
+			if start < 0 {
+				start = i
+			}
+		case 1:
+			kept = true
+		}
+	}
+
+	if !kept || start < 0 {
+		return nil, kept
+	}
+
+	// starting outside means every piece is complete by the end of the ring
+	var pieces [][][]float64
+	var current [][]float64
+	for i := 0; i < n; i++ {
+		a, b := ring[(start+i)%n], ring[(start+i+1)%n]
+		sa, sb := side(a), side(b)
+
+		if current == nil {
+			if sb == 1 {
+				entry := a
+				if sa == -1 {
+					entry = interpolate(a, b, x)
+				}
+				current = [][]float64{entry, b}
+			}
+			continue
+		}
+
+		switch sb {
+		case 1:
+			current = append(current, b)
+		case 0:
+			pieces = append(pieces, append(current, b))
+			current = nil
+		case -1:
+			pieces = append(pieces, append(current, interpolate(a, b, x)))
+			current = nil
+		}
+	}
+
+	return pieces, false
+}
+
+// nextPiece returns the index of the piece starting nearest to the position
+// when walking along the meridian, or -1 if there is none.
+func nextPiece(pieces [][][]float64, p []float64, along func([]float64) float64) int {
+	next, min := -1, math.Inf(1)
+	for i, piece := range pieces {
+		if d := along(piece[0]) - along(p); d >= 0 && d < min {
+			next, min = i, d
+		}
+	}
+
+	return next
+}
+
+// closeRing returns the ring without empty positions and with the first position
+// repeated at the end if it is not already.
+func closeRing(ring [][]float64) [][]float64 {
+	var result [][]float64
+	for _, p := range ring {
+		if len(p) != 0 {
+			result = append(result, p)
+		}
+	}
+
+	if len(result) != 0 && !equalPositions(result[0], result[len(result)-1]) {
+		result = append(result, result[0])
+	}
+
+	return result
+}
+
+// longitudeCell returns which copy of the [-180, 180] range the unwrapped longitude is in,
+// 0 for [-180, 180], 1 for (180, 540] and so on.
+func longitudeCell(x float64) int {
+	return int(math.Ceil((x - 180) / 360))
+}
+
+// shiftPosition returns a copy of the position moved by the given number of 360 degree ranges.
+func shiftPosition(p []float64, cells int) []float64 {
+	if len(p) == 0 {
+		return p
+	}
+
+	r := append([]float64(nil), p...)
+	r[0] -= 360 * float64(cells)
+	return r
+}
+
+func normalizePosition(p []float64) []float64 {
+	if len(p) == 0 {
+		return p
+	}
+
+	r := append([]float64(nil), p...)
+	r[0] = wrapLongitude(r[0])
+	return r
+}
+
+// interpolate returns the position on the segment from a to b with the longitude x.
+func interpolate(a, b []float64, x float64) []float64 {
+	n := len(a)
+	if len(b) < n {
+		n = len(b)
+	}
+
+	t := 0.0
+	if b[0] != a[0] {
+		t = (x - a[0]) / (b[0] - a[0])
+	}
+
+	p := make([]float64, n)
+	p[0] = x
+	for i := 1; i < n; i++ {
+		p[i] = a[i] + t*(b[i]-a[i])
+	}
+
+	return p
+}
+
+// appendLine adds the part of a split line string to the lines, without its empty positions,
+// if it has at least two positions.
+func appendLine(lines [][][]float64, line [][]float64) [][][]float64 {
+	var result [][]float64
+	for _, p := range line {
+		if len(p) != 0 {
+			result = append(result, p)
+		}
+	}
+
+	if len(result) < 2 {
+		return lines
+	}
+
+	return append(lines, result)
+}
+
+// appendPosition adds the position unless it is the same as the last one.
+func appendPosition(path [][]float64, p []float64) [][]float64 {
+	if len(path) != 0 && equalPositions(path[len(path)-1], p) {
+		return path
+	}
+
+	return append(path, p)
+}
+
+// finiteLongitudes returns false if any longitude of the path is NaN or infinite,
+// the crossings of such a path cannot be computed.
+func finiteLongitudes(path [][]float64) bool {
+	for _, p := range path {
+		if len(p) != 0 && (math.IsNaN(p[0]) || math.IsInf(p[0], 0)) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func firstLongitude(path [][]float64) float64 {
+	for _, p := range path {
+		if len(p) != 0 {
+			return p[0]
+		}
+	}
+
+	return math.NaN()
+}
@@ -0,0 +1,247 @@
+package geojson
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestCutAntimeridianLineString(t *testing.T) {
+	g := NewLineStringGeometry([][]float64{{170, 0}, {-170, 10}, {-160, 10}})
+
+	c := CutAntimeridian(g)
+	if !c.IsMultiLineString() {
+		t.Fatalf("should be a multi-line string, got %v", c.Type)
+	}
+
+	expected := [][][]float64{
+		{{170, 0}, {180, 5}},
+		{{-180, 5}, {-170, 10}, {-160, 10}},
+	}
+	if !reflect.DeepEqual(c.MultiLineString, expected) {
+		t.Errorf("incorrect lines, got %v", c.MultiLineString)
+	}
+
+	if !g.IsLineString() || len(g.LineString) != 3 {
+		t.Errorf("should not modify the original geometry")
+	}
+
+	// not crossing
+	c = CutAntimeridian(NewLineStringGeometry([][]float64{{0, 0}, {10, 10}}))
+	if !c.IsLineString() || !reflect.DeepEqual(c.LineString, [][]float64{{0, 0}, {10, 10}}) {
+		t.Errorf("should not cut line string, got %v", c)
+	}
+
+	// touching without crossing
+	c = CutAntimeridian(NewLineStringGeometry([][]float64{{170, 0}, {180, 0}, {170, 10}}))
+	if !c.IsLineString() {
+		t.Errorf("should not cut line string touching the antimeridian, got %v", c)
+	}
+
+	// only changed where cut
+	c = CutAntimeridian(NewLineStringGeometry([][]float64{{0, 0}, {0, 0}, {1, 1}}))
+	if !reflect.DeepEqual(c.LineString, [][]float64{{0, 0}, {0, 0}, {1, 1}}) {
+		t.Errorf("should keep repeated positions, got %v", c.LineString)
+	}
+
+	c = CutAntimeridian(NewLineStringGeometry([][]float64{{170, 0}, {170, 0}, {180, 0}, {190, 0}, {190, 0}}))
+	expected = [][][]float64{
+		{{170, 0}, {170, 0}, {180, 0}},
+		{{-180, 0}, {-170, 0}, {-170, 0}},
+	}
+	if !reflect.DeepEqual(c.MultiLineString, expected) {
+		t.Errorf("should only drop positions at the split, got %v", c.MultiLineString)
+	}
+}
+
+func TestCutAntimeridianMultiLineString(t *testing.T) {
+	g := NewMultiLineStringGeometry(
+		[][]float64{{-170, 0, 100}, {170, 10, 200}},
+		[][]float64{{0, 0}, {10, 10}},
+		[][]float64{{0, 0}},
+	)
+
+	c := CutAntimeridian(g)
+	expected := [][][]float64{
+		{{-170, 0, 100}, {-180, 5, 150}},
+		{{180, 5, 150}, {170, 10, 200}},
+		{{0, 0}, {10, 10}},
+		{{0, 0}},
+	}
+	if !reflect.DeepEqual(c.MultiLineString, expected) {
+		t.Errorf("incorrect lines, got %v", c.MultiLineString)
+	}
+}
+
+func TestCutAntimeridianPolygon(t *testing.T) {
+	g := NewPolygonGeometry([][][]float64{
+		{{170, 0}, {-170, 0}, {-170, 10}, {170, 10}, {170, 0}},
+		{{175, 2}, {175, 4}, {178, 4}, {178, 2}, {175, 2}},
+	})
+
+	c := CutAntimeridian(g)
+	if !c.IsMultiPolygon() || len(c.MultiPolygon) != 2 {
+		t.Fatalf("should be a multi-polygon of 2 polygons, got %v", c)
+	}
+
+	west := [][][]float64{
+		{{180, 10}, {170, 10}, {170, 0}, {180, 0}, {180, 10}},
+		{{175, 2}, {175, 4}, {178, 4}, {178, 2}, {175, 2}},
+	}
+	if !reflect.DeepEqual(c.MultiPolygon[0], west) {
+		t.Errorf("incorrect west polygon, got %v", c.MultiPolygon[0])
+	}
+
+	east := [][][]float64{
+		{{-180, 0}, {-170, 0}, {-170, 10}, {-180, 10}, {-180, 0}},
+	}
+	if !reflect.DeepEqual(c.MultiPolygon[1], east) {
+		t.Errorf("incorrect east polygon, got %v", c.MultiPolygon[1])
+	}
+
+	// unwrapped input is cut the same way
+	u := UnwrapLongitudes(g)
+	if !reflect.DeepEqual(u.Polygon[0], [][]float64{{170, 0}, {190, 0}, {190, 10}, {170, 10}, {170, 0}}) {
+		t.Errorf("incorrect unwrapped polygon, got %v", u.Polygon[0])
+	}
+
+	if c := CutAntimeridian(u); !reflect.DeepEqual(c.MultiPolygon, [][][][]float64{west, east}) {
+		t.Errorf("should cut unwrapped polygon, got %v", c.MultiPolygon)
+	}
+
+	// not crossing
+	c = CutAntimeridian(NewPolygonGeometry([][][]float64{{{0, 0}, {1, 0}, {1, 1}, {0, 0}}}))
+	if !c.IsPolygon() {
+		t.Errorf("should not cut polygon, got %v", c.Type)
+	}
+}
+
+func TestCutAntimeridianConcavePolygon(t *testing.T) {
+	g := NewPolygonGeometry([][][]float64{{
+		{170, 0}, {-170, 0}, {-170, 1}, {175, 1}, {175, 2},
+		{-170, 2}, {-170, 3}, {170, 3}, {170, 0},
+	}})
+
+	c := CutAntimeridian(g)
+	expected := [][][][]float64{
+		{{{180, 1}, {175, 1}, {175, 2}, {180, 2}, {180, 3}, {170, 3}, {170, 0}, {180, 0}, {180, 1}}},
+		{{{-180, 0}, {-170, 0}, {-170, 1}, {-180, 1}, {-180, 0}}},
+		{{{-180, 2}, {-170, 2}, {-170, 3}, {-180, 3}, {-180, 2}}},
+	}
+	if !reflect.DeepEqual(c.MultiPolygon, expected) {
+		t.Errorf("incorrect polygons, got %v", c.MultiPolygon)
+	}
+
+	if r := c.ValidityReason(); r != "Valid Geometry" {
+		t.Errorf("should be valid, got %v", r)
+	}
+
+	// clockwise input and a hole crossing the antimeridian
+	g = NewPolygonGeometry([][][]float64{
+		{{170, 0}, {170, 10}, {-170, 10}, {-170, 0}, {170, 0}},
+		{{175, 2}, {-175, 2}, {-175, 4}, {175, 4}, {175, 2}},
+	})
+
+	c = CutAntimeridian(g)
+	expected = [][][][]float64{
+		{{{180, 10}, {170, 10}, {170, 0}, {180, 0}, {180, 2}, {175, 2}, {175, 4}, {180, 4}, {180, 10}}},
+		{{{-180, 0}, {-170, 0}, {-170, 10}, {-180, 10}, {-180, 4}, {-175, 4}, {-175, 2}, {-180, 2}, {-180, 0}}},
+	}
+	if !reflect.DeepEqual(c.MultiPolygon, expected) {
+		t.Errorf("incorrect polygons, got %v", c.MultiPolygon)
+	}
+
+	if r := c.ValidityReason(); r != "Valid Geometry" {
+		t.Errorf("should be valid, got %v", r)
+	}
+}
+
+func TestCutAntimeridianNonFinite(t *testing.T) {
+	c := CutAntimeridian(NewPointGeometry([]float64{1e18, 0}))
+	if c.Point[0] < -180 || c.Point[0] > 180 {
+		t.Errorf("should normalize large longitude, got %v", c.Point)
+	}
+
+	c = CutAntimeridian(NewPointGeometry([]float64{math.Inf(1), 0}))
+	if !math.IsInf(c.Point[0], 1) {
+		t.Errorf("should not change infinite longitude, got %v", c.Point)
+	}
+
+	ls := [][]float64{{170, 0}, {math.Inf(1), 0}}
+	c = CutAntimeridian(NewLineStringGeometry(ls))
+	if !c.IsLineString() || !reflect.DeepEqual(c.LineString, ls) {
+		t.Errorf("should not cut line string, got %v", c)
+	}
+
+	polygon := [][][]float64{{{170, 0}, {-170, 0}, {math.NaN(), 10}, {170, 0}}}
+	c = CutAntimeridian(NewPolygonGeometry(polygon))
+	if !c.IsPolygon() || len(c.Polygon[0]) != 4 {
+		t.Errorf("should not cut polygon, got %v", c)
+	}
+
+	u := UnwrapLongitudes(NewLineStringGeometry([][]float64{{170, 0}, {math.Inf(-1), 0}, {-170, 0}}))
+	if !math.IsInf(u.LineString[1][0], -1) || u.LineString[2][0] != 190 {
+		t.Errorf("should skip infinite longitude, got %v", u.LineString)
+	}
+}
+
+func TestCutAntimeridianBoundingBox(t *testing.T) {
+	g := NewLineStringGeometry([][]float64{{170, 0}, {190, 10}})
+	g.BoundingBox = []float64{170, 0, 190, 10}
+
+	c := CutAntimeridian(g)
+	if !reflect.DeepEqual(c.BoundingBox, []float64{170, 0, -170, 10}) {
+		t.Errorf("should recompute bbox, got %v", c.BoundingBox)
+	}
+
+	if !reflect.DeepEqual(g.BoundingBox, []float64{170, 0, 190, 10}) {
+		t.Errorf("should not modify the original bbox")
+	}
+
+	c = CutAntimeridian(NewLineStringGeometry([][]float64{{170, 0}, {190, 10}}))
+	if c.BoundingBox != nil {
+		t.Errorf("should not add a bbox, got %v", c.BoundingBox)
+	}
+}
+
+func TestCutAntimeridianCollection(t *testing.T) {
+	g := NewCollectionGeometry(
+		NewPointGeometry([]float64{190, 0}),
+		NewLineStringGeometry([][]float64{{170, 0}, {-170, 0}}),
+	)
+
+	c := CutAntimeridian(g)
+	if !reflect.DeepEqual(c.Geometries[0].Point, []float64{-170, 0}) {
+		t.Errorf("should normalize point, got %v", c.Geometries[0].Point)
+	}
+
+	if !c.Geometries[1].IsMultiLineString() {
+		t.Errorf("should cut line string, got %v", c.Geometries[1].Type)
+	}
+}
+
+func TestUnwrapLongitudes(t *testing.T) {
+	g := NewMultiLineStringGeometry(
+		[][]float64{{170, 0}, {180, 5}},
+		[][]float64{{-180, 5}, {-170, 10}},
+	)
+
+	u := UnwrapLongitudes(g)
+	expected := [][][]float64{
+		{{170, 0}, {180, 5}},
+		{{180, 5}, {190, 10}},
+	}
+	if !reflect.DeepEqual(u.MultiLineString, expected) {
+		t.Errorf("incorrect unwrapped lines, got %v", u.MultiLineString)
+	}
+
+	if g.MultiLineString[1][0][0] != -180 {
+		t.Errorf("should not modify the original geometry")
+	}
+
+	// round trip
+	c := CutAntimeridian(NewLineStringGeometry(append(u.MultiLineString[0], u.MultiLineString[1][1:]...)))
+	if !reflect.DeepEqual(c.MultiLineString, [][][]float64{{{170, 0}, {180, 5}}, {{-180, 5}, {-170, 10}}}) {
+		t.Errorf("should cut back to the original, got %v", c.MultiLineString)
+	}
+}
@@ -1,6 +1,9 @@
 package geojson
 
-import "fmt"
+import (
+	"fmt"
+	"math"
+)
 
 func decodeBoundingBox(bb interface{}) ([]float64, error) {
 	if bb == nil {
@@ -12,17 +15,499 @@ func decodeBoundingBox(bb interface{}) ([]float64, error) {
 		return f, nil
 	case []interface{}:
 		bb := make([]float64, 0, 4)
-		for _, v := range f {
+		for i, v := range f {
 			switch c := v.(type) {
 			case float64:
 				bb = append(bb, c)
 			default:
-				return nil, fmt.Errorf("bounding box coordinate not usable, got %T", v)
+				return nil, prefixIndex(&DecodeError{Expected: "number", Actual: jsonType(v)}, i)
 			}
 
 		}
 		return bb, nil
 	default:
-		return nil, fmt.Errorf("bounding box property not usable, got %T", bb)
+		return nil, &DecodeError{Expected: "bbox array", Actual: jsonType(bb)}
 	}
 }
+
+// ComputeBoundingBox returns the bbox of the positions of the geometry, including
+// those of the members of a geometry collection. The bbox is 3D if all the positions
+// have a z value. Nil is returned for an empty geometry.
+func (g *Geometry) ComputeBoundingBox() []float64 {
+	return computeBoundingBox(g)
+}
+
+// ComputeBoundingBox returns the bbox of the feature's geometry.
+func (f *Feature) ComputeBoundingBox() []float64 {
+	return computeBoundingBox(f.Geometry)
+}
+
+// ComputeBoundingBox returns the bbox of the geometries of all the features in the collection.
+func (fc *FeatureCollection) ComputeBoundingBox() []float64 {
+	geometries := make([]*Geometry, 0, len(fc.Features))
+	for _, f := range fc.Features {
+		if f != nil {
+			geometries = append(geometries, f.Geometry)
+		}
+	}
+
+	return computeBoundingBox(geometries...)
+}
+
+// FillBoundingBoxes sets the bbox of the geometry, and of the members
+// of a geometry collection, to the computed bbox.
+func (g *Geometry) FillBoundingBoxes() {
+	if g == nil {
+		return
+	}
+
+	for _, c := range g.Geometries {
+		c.FillBoundingBoxes()
+	}
+	g.BoundingBox = g.ComputeBoundingBox()
+}
+
+// FillBoundingBoxes sets the bbox of the feature and its geometry to the computed bbox.
+func (f *Feature) FillBoundingBoxes() {
+	f.Geometry.FillBoundingBoxes()
+	f.BoundingBox = f.ComputeBoundingBox()
+}
+
+// FillBoundingBoxes sets the bbox of the collection and all its features to the computed bbox.
+func (fc *FeatureCollection) FillBoundingBoxes() {
+	for _, f := range fc.Features {
+		if f != nil {
+			f.FillBoundingBoxes()
+		}
+	}
+	fc.BoundingBox = fc.ComputeBoundingBox()
+}
+
+// A BoundingBoxError is returned when a bbox disagrees with the positions of the geometries
+// of its object, either not containing all of them or being larger than needed.
+type BoundingBoxError struct {
+	// Path is the location of the bbox, e.g. features[3].geometry.bbox
+	Path string
+
+	// BoundingBox is the bbox of the object and Computed the one of its positions.
+	BoundingBox []float64
+	Computed    []float64
+}
+
+func (e *BoundingBoxError) Error() string {
+	return fmt.Sprintf("%s: %v does not match the positions, expected %v", e.Path, e.BoundingBox, e.Computed)
+}
+
+// CheckBoundingBox verifies the bbox of the geometry, and the members of a geometry collection,
+// contain all their positions and that every edge of the bbox is reached by a position,
+// i.e. the bbox is no larger than needed. Missing bboxes are not an error. A bbox with a west
+// value greater than the east value crosses the antimeridian. Only the values within
+// the dimension of the bbox are compared.
+func (g *Geometry) CheckBoundingBox() error {
+	if g == nil {
+		return nil
+	}
+
+	for i, c := range g.Geometries {
+		if err := c.CheckBoundingBox(); err != nil {
+			return prefixBoundingBoxError(err, indexPath("geometries", i))
+		}
+	}
+
+	return checkBoundingBox(g.BoundingBox, g)
+}
+
+// CheckBoundingBox verifies the bbox of the feature and its geometry match the positions,
+// see Geometry.CheckBoundingBox.
+func (f *Feature) CheckBoundingBox() error {
+	if err := f.Geometry.CheckBoundingBox(); err != nil {
+		return prefixBoundingBoxError(err, "geometry")
+	}
+
+	return checkBoundingBox(f.BoundingBox, f.Geometry)
+}
+
+// CheckBoundingBox verifies the bbox of the collection and all its features match the positions,
+// see Geometry.CheckBoundingBox.
+func (fc *FeatureCollection) CheckBoundingBox() error {
+	geometries := make([]*Geometry, 0, len(fc.Features))
+	for i, f := range fc.Features {
+		if f == nil {
+			continue
+		}
+
+		if err := f.CheckBoundingBox(); err != nil {
+			return prefixBoundingBoxError(err, indexPath("features", i))
+		}
+		geometries = append(geometries, f.Geometry)
+	}
+
+	return checkBoundingBox(fc.BoundingBox, geometries...)
+}
+
+func checkBoundingBox(bb []float64, geometries ...*Geometry) error {
+	if len(bb) < 4 || len(bb)%2 != 0 {
+		return nil
+	}
+
+	d := len(bb) / 2
+	valid := true
+
+	// whether a position is on each edge of the bbox, min values then max values
+	reached := make([]bool, len(bb))
+	for _, g := range geometries {
+		eachPosition(g, func(p []float64) {
+			if !valid || len(p) < 2 {
+				return
+			}
+
+			// longitude, taking into account crossing the antimeridian
+			if w, e := bb[0], bb[d]; (w <= e && (p[0] < w || p[0] > e)) || (w > e && p[0] < w && p[0] > e) {
+				valid = false
+			}
+
+			for i := 1; i < d && i < len(p); i++ {
+				if p[i] < bb[i] || p[i] > bb[d+i] {
+					valid = false
+				}
+			}
+
+			for i := 0; i < d && i < len(p); i++ {
+				reached[i] = reached[i] || p[i] == bb[i]
+				reached[d+i] = reached[d+i] || p[i] == bb[d+i]
+			}
+		})
+	}
+
+	for _, r := range reached {
+		valid = valid && r
+	}
+
+	if valid {
+		return nil
+	}
+
+	return &BoundingBoxError{Path: "bbox", BoundingBox: bb, Computed: computeBoundingBox(geometries...)}
+}
+
+func prefixBoundingBoxError(err error, prefix string) error {
+	if e, ok := err.(*BoundingBoxError); ok {
+		e.Path = prefix + "." + e.Path
+	}
+
+	return err
+}
+
+func computeBoundingBox(geometries ...*Geometry) []float64 {
+	min := []float64{math.Inf(1), math.Inf(1), math.Inf(1)}
+	max := []float64{math.Inf(-1), math.Inf(-1), math.Inf(-1)}
+	dimension := 3
+
+	found := false
+	for _, g := range geometries {
+		eachPosition(g, func(p []float64) {
+			if len(p) < 2 {
+				return
+			}
+
+			found = true
+			if len(p) < dimension {
+				dimension = len(p)
+			}
+
+			for i := 0; i < len(p) && i < 3; i++ {
+				min[i] = math.Min(min[i], p[i])
+				max[i] = math.Max(max[i], p[i])
+			}
+		})
+	}
+
+	if !found {
+		return nil
+	}
+
+	bb := make([]float64, 0, 2*dimension)
+	bb = append(bb, min[:dimension]...)
+	return append(bb, max[:dimension]...)
+}
+
+// A BoundingBox is a 2D, [west, south, east, north], or 3D, [west, south, min z, east, north, max z],
+// bbox as defined by RFC 7946. A west value greater than the east value means the box
+// crosses the antimeridian. The BoundingBox fields of the objects can be converted directly,
+// e.g. BoundingBox(f.BoundingBox) and f.BoundingBox = bb.
+type BoundingBox []float64
+
+// NewBoundingBox returns the bbox of the positions, 3D if all of them have a z value.
+// Nil is returned if there are no positions.
+func NewBoundingBox(positions ...[]float64) BoundingBox {
+	return computeBoundingBox(NewMultiPointGeometry(positions...))
+}
+
+// Dimension returns 2 or 3 for a 2D or 3D bbox and 0 if the bbox is empty or malformed.
+func (bb BoundingBox) Dimension() int {
+	if len(bb) < 4 || len(bb)%2 != 0 {
+		return 0
+	}
+
+	return len(bb) / 2
+}
+
+// West returns the minimum longitude of the bbox, NaN if the bbox is empty or malformed.
+func (bb BoundingBox) West() float64 {
+	if bb.Dimension() == 0 {
+		return math.NaN()
+	}
+
+	return bb[0]
+}
+
+// South returns the minimum latitude of the bbox, NaN if the bbox is empty or malformed.
+func (bb BoundingBox) South() float64 {
+	if bb.Dimension() == 0 {
+		return math.NaN()
+	}
+
+	return bb[1]
+}
+
+// East returns the maximum longitude of the bbox, NaN if the bbox is empty or malformed.
+func (bb BoundingBox) East() float64 {
+	if bb.Dimension() == 0 {
+		return math.NaN()
+	}
+
+	return bb[len(bb)/2]
+}
+
+// North returns the maximum latitude of the bbox, NaN if the bbox is empty or malformed.
+func (bb BoundingBox) North() float64 {
+	if bb.Dimension() == 0 {
+		return math.NaN()
+	}
+
+	return bb[len(bb)/2+1]
+}
+
+// CrossesAntimeridian returns true if the west value is greater than the east value.
+func (bb BoundingBox) CrossesAntimeridian() bool {
+	return bb.Dimension() != 0 && bb.West() > bb.East()
+}
+
+// Contains returns true if the position is within the bbox, edges included.
+// The z value is only compared if both the bbox and the position have one.
+func (bb BoundingBox) Contains(p []float64) bool {
+	d := bb.Dimension()
+	if d == 0 || len(p) < 2 {
+		return false
+	}
+
+	if !bb.containsLongitude(p[0]) {
+		return false
+	}
+
+	for i := 1; i < d && i < len(p); i++ {
+		if p[i] < bb[i] || p[i] > bb[d+i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Intersects returns true if the bboxes have at least one position in common.
+func (bb BoundingBox) Intersects(o BoundingBox) bool {
+	d, od := bb.Dimension(), o.Dimension()
+	if d == 0 || od == 0 {
+		return false
+	}
+
+	for i := 1; i < d && i < od; i++ {
+		if bb[i] > o[od+i] || o[i] > bb[d+i] {
+			return false
+		}
+	}
+
+	for _, a := range bb.longitudes() {
+		for _, b := range o.longitudes() {
+			if a[0] <= b[1] && b[0] <= a[1] {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// Union returns the smallest bbox containing both bboxes. If either crosses the antimeridian
+// the result is the narrowest box, possibly crossing the antimeridian, containing both.
+// The result is 3D only if both bboxes are.
+func (bb BoundingBox) Union(o BoundingBox) BoundingBox {
+	d, od := bb.Dimension(), o.Dimension()
+	switch {
+	case d == 0 && od == 0:
+		return nil
+	case d == 0:
+		return append(BoundingBox(nil), o...)
+	case od == 0:
+		return append(BoundingBox(nil), bb...)
+	}
+
+	if od < d {
+		d = od
+	}
+
+	min := make([]float64, d)
+	max := make([]float64, d)
+	for i := 1; i < d; i++ {
+		min[i] = math.Min(bb[i], o[i])
+		max[i] = math.Max(bb.max(i), o.max(i))
+	}
+
+	if !bb.CrossesAntimeridian() && !o.CrossesAntimeridian() {
+		min[0] = math.Min(bb.West(), o.West())
+		max[0] = math.Max(bb.East(), o.East())
+	} else {
+		min[0], max[0] = unionLongitudes(bb.West(), bb.width(), o.West(), o.width())
+	}
+
+	return append(BoundingBox(min), max...)
+}
+
+// Extend returns the bbox grown to contain the position, see Union.
+func (bb BoundingBox) Extend(p []float64) BoundingBox {
+	return bb.Union(NewBoundingBox(p))
+}
+
+// ExtendGeometry returns the bbox grown to contain all the positions of the geometry, see Union.
+func (bb BoundingBox) ExtendGeometry(g *Geometry) BoundingBox {
+	return bb.Union(g.ComputeBoundingBox())
+}
+
+// Center returns the position at the center of the bbox, taking into account
+// crossing the antimeridian. Nil is returned for an empty bbox.
+func (bb BoundingBox) Center() []float64 {
+	d := bb.Dimension()
+	if d == 0 {
+		return nil
+	}
+
+	c := make([]float64, d)
+	c[0] = wrapLongitude(bb.West() + bb.width()/2)
+	for i := 1; i < d; i++ {
+		c[i] = (bb[i] + bb[d+i]) / 2
+	}
+
+	return c
+}
+
+// Pad returns the bbox with the longitudes and latitudes extended by the given amount.
+// Latitudes are limited to [-90, 90] and longitudes wrap around the antimeridian,
+// up to covering [-180, 180].
+func (bb BoundingBox) Pad(amount float64) BoundingBox {
+	d := bb.Dimension()
+	if d == 0 {
+		return nil
+	}
+
+	r := append(BoundingBox(nil), bb...)
+	r[1] = math.Max(bb.South()-amount, -90)
+	r[d+1] = math.Min(bb.North()+amount, 90)
+
+	if bb.width()+2*amount >= 360 {
+		r[0], r[d] = -180, 180
+	} else {
+		r[0], r[d] = wrapLongitude(bb.West()-amount), wrapLongitude(bb.East()+amount)
+	}
+
+	return r
+}
+
+// Polygon returns the bbox as a polygon geometry with a counterclockwise exterior ring.
+// A bbox crossing the antimeridian is returned as a multi-polygon split at the antimeridian.
+// Nil is returned for an empty bbox.
+func (bb BoundingBox) Polygon() *Geometry {
+	if bb.Dimension() == 0 {
+		return nil
+	}
+
+	s, n := bb.South(), bb.North()
+	ring := func(w, e float64) [][][]float64 {
+		return [][][]float64{{{w, s}, {e, s}, {e, n}, {w, n}, {w, s}}}
+	}
+
+	if bb.CrossesAntimeridian() {
+		return NewMultiPolygonGeometry(ring(bb.West(), 180), ring(-180, bb.East()))
+	}
+
+	return NewPolygonGeometry(ring(bb.West(), bb.East()))
+}
+
+func (bb BoundingBox) max(i int) float64 {
+	return bb[len(bb)/2+i]
+}
+
+func (bb BoundingBox) containsLongitude(x float64) bool {
+	if bb.CrossesAntimeridian() {
+		return x >= bb.West() || x <= bb.East()
+	}
+
+	return x >= bb.West() && x <= bb.East()
+}
+
+// longitudes returns the ranges of longitudes of the bbox, two if it crosses the antimeridian.
+func (bb BoundingBox) longitudes() [][2]float64 {
+	if bb.CrossesAntimeridian() {
+		return [][2]float64{{bb.West(), 180}, {-180, bb.East()}}
+	}
+
+	return [][2]float64{{bb.West(), bb.East()}}
+}
+
+// width returns the number of degrees of longitude covered by the bbox.
+func (bb BoundingBox) width() float64 {
+	if bb.CrossesAntimeridian() {
+		return bb.East() - bb.West() + 360
+	}
+
+	return bb.East() - bb.West()
+}
+
+// unionLongitudes returns the west and east values of the narrowest range of longitudes
+// containing both ranges, each given as a west value and a width in degrees.
+func unionLongitudes(w1, width1, w2, width2 float64) (float64, float64) {
+	// the range has to start at one of the two west values
+	span := func(w1, width1, w2, width2 float64) float64 {
+		return math.Max(width1, math.Mod(w2-w1+360, 360)+width2)
+	}
+
+	w, width := w1, span(w1, width1, w2, width2)
+	if s := span(w2, width2, w1, width1); s < width {
+		w, width = w2, s
+	}
+
+	if width >= 360 {
+		return -180, 180
+	}
+
+	return w, wrapLongitude(w + width)
+}
+
+// wrapLongitude returns the longitude in the range [-180, 180].
+// NaN and infinite values are returned unchanged.
+func wrapLongitude(x float64) float64 {
+	if (x >= -180 && x <= 180) || math.IsNaN(x) || math.IsInf(x, 0) {
+		return x
+	}
+
+	r := math.Mod(x+180, 360)
+	if r < 0 {
+		r += 360
+	}
+
+	if r == 0 && x > 0 {
+		// positive values on the antimeridian stay on the east side of the range
+		return 180
+	}
+
+	return r - 180
+}
@@ -0,0 +1,284 @@
+package geojson
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestGeometryComputeBoundingBox(t *testing.T) {
+	cases := []struct {
+		name     string
+		geometry *Geometry
+		expected []float64
+	}{
+		{"point", NewPointGeometry([]float64{1, 2}), []float64{1, 2, 1, 2}},
+		{"line string", NewLineStringGeometry([][]float64{{1, 2}, {-3, 4}}), []float64{-3, 2, 1, 4}},
+		{"3d polygon", NewPolygonGeometry([][][]float64{{{0, 0, 5}, {2, 0, 1}, {2, 3, 2}, {0, 0, 5}}}), []float64{0, 0, 1, 2, 3, 5}},
+		{"mixed dimensions", NewMultiPointGeometry([]float64{0, 0, 5}, []float64{2, 3}), []float64{0, 0, 2, 3}},
+		{"nested collection", NewCollectionGeometry(
+			NewPointGeometry([]float64{1, 2}),
+			NewCollectionGeometry(NewMultiPolygonGeometry([][][]float64{{{-1, -2}, {5, 6}, {0, 0}, {-1, -2}}})),
+		), []float64{-1, -2, 5, 6}},
+		{"empty", NewEmptyGeometry(GeometryLineString), nil},
+	}
+
+	for _, tc := range cases {
+		if bb := tc.geometry.ComputeBoundingBox(); !reflect.DeepEqual(bb, tc.expected) {
+			t.Errorf("%s: incorrect bbox, got %v", tc.name, bb)
+		}
+	}
+}
+
+func TestFeatureCollectionFillBoundingBoxes(t *testing.T) {
+	fc := NewFeatureCollection()
+	fc.AddFeature(NewPointFeature([]float64{1, 2}))
+	fc.AddFeature(NewFeature(nil))
+	fc.AddFeature(NewCollectionFeature(NewPointGeometry([]float64{-5, 3}), NewPointGeometry([]float64{0, 10})))
+
+	fc.FillBoundingBoxes()
+
+	if !reflect.DeepEqual(fc.BoundingBox, []float64{-5, 2, 1, 10}) {
+		t.Errorf("incorrect collection bbox, got %v", fc.BoundingBox)
+	}
+
+	if !reflect.DeepEqual(fc.Features[0].BoundingBox, []float64{1, 2, 1, 2}) {
+		t.Errorf("incorrect feature bbox, got %v", fc.Features[0].BoundingBox)
+	}
+
+	if fc.Features[1].BoundingBox != nil {
+		t.Errorf("should not have a bbox without geometry, got %v", fc.Features[1].BoundingBox)
+	}
+
+	if !reflect.DeepEqual(fc.Features[2].Geometry.Geometries[1].BoundingBox, []float64{0, 10, 0, 10}) {
+		t.Errorf("incorrect member bbox, got %v", fc.Features[2].Geometry.Geometries[1].BoundingBox)
+	}
+
+	if err := fc.CheckBoundingBox(); err != nil {
+		t.Errorf("filled bboxes should be valid, got %v", err)
+	}
+}
+
+func TestCheckBoundingBox(t *testing.T) {
+	fc := NewFeatureCollection()
+	fc.AddFeature(NewPointFeature([]float64{1, 2}))
+	fc.AddFeature(NewCollectionFeature(NewPointGeometry([]float64{-5, 3}), NewPointGeometry([]float64{0, 10})))
+	fc.Features[1].Geometry.Geometries[1].BoundingBox = []float64{0, 0, 5, 5}
+
+	err := fc.CheckBoundingBox()
+
+	var bbe *BoundingBoxError
+	if !errors.As(err, &bbe) {
+		t.Fatalf("should return bbox error, got %v", err)
+	}
+
+	if bbe.Path != "features[1].geometry.geometries[1].bbox" {
+		t.Errorf("incorrect path, got %v", bbe.Path)
+	}
+
+	if err.Error() != "features[1].geometry.geometries[1].bbox: [0 0 5 5] does not match the positions, expected [0 10 0 10]" {
+		t.Errorf("incorrect error message, got %v", err)
+	}
+
+	// crossing the antimeridian
+	g := NewLineStringGeometry([][]float64{{170, 0}, {-170, 1}})
+	g.BoundingBox = []float64{170, 0, -170, 1}
+	if err := g.CheckBoundingBox(); err != nil {
+		t.Errorf("should allow bbox crossing the antimeridian, got %v", err)
+	}
+
+	g.BoundingBox = []float64{175, 0, -170, 1}
+	if err := g.CheckBoundingBox(); err == nil {
+		t.Errorf("should return error for position outside of antimeridian bbox")
+	}
+
+	// larger than the positions
+	g = NewLineStringGeometry([][]float64{{1, 2, 3}, {4, 5, 6}})
+	for _, bb := range [][]float64{{-180, -90, 180, 90}, {1, 2, 4, 6}, {1, 2, 0, 4, 5, 6}} {
+		g.BoundingBox = bb
+		if err := g.CheckBoundingBox(); !errors.As(err, &bbe) {
+			t.Errorf("should return bbox error for %v, got %v", bb, err)
+		}
+	}
+
+	for _, bb := range [][]float64{{1, 2, 4, 5}, {1, 2, 3, 4, 5, 6}} {
+		g.BoundingBox = bb
+		if err := g.CheckBoundingBox(); err != nil {
+			t.Errorf("should match bbox %v, got %v", bb, err)
+		}
+	}
+}
+
+func TestBoundingBoxContains(t *testing.T) {
+	bb := BoundingBox{0, 0, 10, 10}
+	if !bb.Contains([]float64{5, 10}) || bb.Contains([]float64{-1, 5}) {
+		t.Errorf("incorrect contains")
+	}
+
+	bb = BoundingBox{170, -10, 0, -170, 10, 100}
+	if !bb.Contains([]float64{175, 0, 50}) || !bb.Contains([]float64{-175, 0}) {
+		t.Errorf("should contain positions across the antimeridian")
+	}
+
+	if bb.Contains([]float64{0, 0}) || bb.Contains([]float64{175, 0, 101}) {
+		t.Errorf("should not contain positions outside")
+	}
+
+	if bb.West() != 170 || bb.South() != -10 || bb.East() != -170 || bb.North() != 10 {
+		t.Errorf("incorrect accessors, got %v %v %v %v", bb.West(), bb.South(), bb.East(), bb.North())
+	}
+}
+
+func TestBoundingBoxIntersects(t *testing.T) {
+	cases := []struct {
+		name       string
+		a, b       BoundingBox
+		intersects bool
+	}{
+		{"overlap", BoundingBox{0, 0, 10, 10}, BoundingBox{5, 5, 15, 15}, true},
+		{"touching", BoundingBox{0, 0, 10, 10}, BoundingBox{10, 0, 15, 15}, true},
+		{"disjoint", BoundingBox{0, 0, 10, 10}, BoundingBox{11, 0, 15, 15}, false},
+		{"disjoint latitude", BoundingBox{0, 0, 10, 10}, BoundingBox{0, 11, 15, 15}, false},
+		{"antimeridian", BoundingBox{170, 0, -170, 10}, BoundingBox{-175, 5, -160, 15}, true},
+		{"both antimeridian", BoundingBox{170, 0, -170, 10}, BoundingBox{175, 5, -175, 15}, true},
+		{"outside antimeridian", BoundingBox{170, 0, -170, 10}, BoundingBox{0, 0, 10, 10}, false},
+	}
+
+	for _, tc := range cases {
+		if v := tc.a.Intersects(tc.b); v != tc.intersects {
+			t.Errorf("%s: incorrect intersects, got %v", tc.name, v)
+		}
+
+		if v := tc.b.Intersects(tc.a); v != tc.intersects {
+			t.Errorf("%s: should be symmetric, got %v", tc.name, v)
+		}
+	}
+}
+
+func TestBoundingBoxUnion(t *testing.T) {
+	cases := []struct {
+		name     string
+		a, b     BoundingBox
+		expected BoundingBox
+	}{
+		{"simple", BoundingBox{0, 0, 10, 10}, BoundingBox{5, -5, 15, 5}, BoundingBox{0, -5, 15, 10}},
+		{"empty", nil, BoundingBox{5, -5, 15, 5}, BoundingBox{5, -5, 15, 5}},
+		{"3d with 2d", BoundingBox{0, 0, 1, 10, 10, 2}, BoundingBox{5, -5, 15, 5}, BoundingBox{0, -5, 15, 10}},
+		{"3d", BoundingBox{0, 0, 1, 10, 10, 2}, BoundingBox{5, -5, 0, 15, 5, 1}, BoundingBox{0, -5, 0, 15, 10, 2}},
+		{"antimeridian", BoundingBox{170, 0, -170, 10}, BoundingBox{-175, 5, -160, 15}, BoundingBox{170, 0, -160, 15}},
+		{"antimeridian west", BoundingBox{170, 0, -170, 10}, BoundingBox{160, 5, 165, 15}, BoundingBox{160, 0, -170, 15}},
+		{"full", BoundingBox{10, 0, -10, 10}, BoundingBox{-20, 0, 20, 10}, BoundingBox{-180, 0, 180, 10}},
+	}
+
+	for _, tc := range cases {
+		if bb := tc.a.Union(tc.b); !reflect.DeepEqual(bb, tc.expected) {
+			t.Errorf("%s: incorrect union, got %v", tc.name, bb)
+		}
+	}
+
+	var bb BoundingBox
+	bb = bb.Extend([]float64{1, 2}).Extend([]float64{-1, 5})
+	if !reflect.DeepEqual(bb, BoundingBox{-1, 2, 1, 5}) {
+		t.Errorf("incorrect extend, got %v", bb)
+	}
+
+	bb = bb.ExtendGeometry(NewLineStringGeometry([][]float64{{0, 0}, {3, 3}}))
+	if !reflect.DeepEqual(bb, BoundingBox{-1, 0, 3, 5}) {
+		t.Errorf("incorrect extend geometry, got %v", bb)
+	}
+}
+
+func TestBoundingBoxCenterPad(t *testing.T) {
+	if c := (BoundingBox{0, 0, 1, 10, 10, 3}).Center(); !reflect.DeepEqual(c, []float64{5, 5, 2}) {
+		t.Errorf("incorrect center, got %v", c)
+	}
+
+	if c := (BoundingBox{170, 0, -170, 10}).Center(); !reflect.DeepEqual(c, []float64{180, 5}) {
+		t.Errorf("incorrect antimeridian center, got %v", c)
+	}
+
+	if bb := (BoundingBox{0, 0, 10, 10}).Pad(1); !reflect.DeepEqual(bb, BoundingBox{-1, -1, 11, 11}) {
+		t.Errorf("incorrect pad, got %v", bb)
+	}
+
+	if bb := (BoundingBox{175, 85, 179, 89}).Pad(2); !reflect.DeepEqual(bb, BoundingBox{173, 83, -179, 90}) {
+		t.Errorf("should wrap and clamp, got %v", bb)
+	}
+
+	if bb := (BoundingBox{-170, 0, 170, 10}).Pad(20); !reflect.DeepEqual(bb, BoundingBox{-180, -20, 180, 30}) {
+		t.Errorf("should cover all longitudes, got %v", bb)
+	}
+
+	if c := (BoundingBox{0, 0, math.Inf(1), 1}).Center(); !math.IsInf(c[0], 1) {
+		t.Errorf("should return infinite center, got %v", c)
+	}
+
+	if bb := (BoundingBox{0, 0, 1e18, 1}).Pad(1); bb[0] != -180 || bb[2] != 180 {
+		t.Errorf("should cover all longitudes, got %v", bb)
+	}
+}
+
+func TestBoundingBoxEdges(t *testing.T) {
+	bb := BoundingBox{1, 2, 3, 4, 5, 6}
+	if bb.West() != 1 || bb.South() != 2 || bb.East() != 4 || bb.North() != 5 {
+		t.Errorf("incorrect edges, got %v %v %v %v", bb.West(), bb.South(), bb.East(), bb.North())
+	}
+
+	for _, bb := range []BoundingBox{nil, {1, 2}, {1, 2, 3, 4, 5}} {
+		if !math.IsNaN(bb.West()) || !math.IsNaN(bb.South()) || !math.IsNaN(bb.East()) || !math.IsNaN(bb.North()) {
+			t.Errorf("should return NaN for %v", bb)
+		}
+	}
+}
+
+func TestWrapLongitude(t *testing.T) {
+	cases := []struct {
+		x, expected float64
+	}{
+		{x: 180, expected: 180},
+		{x: -180, expected: -180},
+		{x: 190, expected: -170},
+		{x: -190, expected: 170},
+		{x: 540, expected: 180},
+		{x: -540, expected: -180},
+		{x: 1e18 + 20, expected: wrapLongitude(1e18 + 20)},
+	}
+
+	for _, tc := range cases {
+		if v := wrapLongitude(tc.x); v != tc.expected || v < -180 || v > 180 {
+			t.Errorf("incorrect longitude for %v, got %v", tc.x, v)
+		}
+	}
+
+	if v := wrapLongitude(math.Inf(-1)); !math.IsInf(v, -1) {
+		t.Errorf("should return infinity unchanged, got %v", v)
+	}
+
+	if v := wrapLongitude(math.NaN()); !math.IsNaN(v) {
+		t.Errorf("should return NaN unchanged, got %v", v)
+	}
+}
+
+func TestBoundingBoxPolygon(t *testing.T) {
+	g := BoundingBox{0, 0, 10, 5}.Polygon()
+	if !g.IsPolygon() || !reflect.DeepEqual(g.Polygon, [][][]float64{{{0, 0}, {10, 0}, {10, 5}, {0, 5}, {0, 0}}}) {
+		t.Errorf("incorrect polygon, got %v", g)
+	}
+
+	g = BoundingBox{170, 0, -170, 5}.Polygon()
+	if !g.IsMultiPolygon() || len(g.MultiPolygon) != 2 {
+		t.Fatalf("should be a multi-polygon, got %v", g)
+	}
+
+	if !reflect.DeepEqual(g.MultiPolygon[1], [][][]float64{{{-180, 0}, {-170, 0}, {-170, 5}, {-180, 5}, {-180, 0}}}) {
+		t.Errorf("incorrect polygon, got %v", g.MultiPolygon[1])
+	}
+
+	// converting to and from the fields
+	f := NewFeature(g)
+	f.BoundingBox = BoundingBox(f.ComputeBoundingBox()).Pad(1)
+	if len(f.BoundingBox) != 4 {
+		t.Errorf("should convert to the field, got %v", f.BoundingBox)
+	}
+}
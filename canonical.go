@@ -0,0 +1,196 @@
+package geojson
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+)
+
+// Hash returns the SHA-256 digest of the canonical encoding of the object.
+// Objects that are equivalent, regardless of member order or number formatting,
+// have the same hash. Additional options, such as Precision, are applied before hashing.
+// As with Canonical, an error is returned for integers, such as 64-bit ids decoded with
+// UseNumber, that cannot be represented exactly as a double, rather than risk two objects
+// with different ids having the same hash.
+func Hash(o Object, opts ...MarshalOption) ([sha256.Size]byte, error) {
+	data, err := Marshal(o, append(opts, Canonical())...)
+	if err != nil {
+		return [sha256.Size]byte{}, err
+	}
+
+	return sha256.Sum256(data), nil
+}
+
+// canonicalize rewrites the JSON following the JSON Canonicalization Scheme, RFC 8785.
+func canonicalize(data []byte) ([]byte, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+
+	var v interface{}
+	if err := dec.Decode(&v); err != nil {
+		return nil, err
+	}
+
+	buf := bytes.NewBuffer(make([]byte, 0, len(data)))
+	if err := writeCanonical(buf, v); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func writeCanonical(buf *bytes.Buffer, v interface{}) error {
+	switch v := v.(type) {
+	case nil:
+		buf.WriteString("null")
+	case bool:
+		buf.WriteString(strconv.FormatBool(v))
+	case string:
+		writeCanonicalString(buf, v)
+	case json.Number:
+		f, err := strconv.ParseFloat(string(v), 64)
+		if err != nil {
+			return fmt.Errorf("number %s not representable as a double", v)
+		}
+
+		if !exactInteger(v, f) {
+			return fmt.Errorf("integer %s not representable exactly as a double", v)
+		}
+		buf.WriteString(canonicalNumber(f))
+	case []interface{}:
+		buf.WriteByte('[')
+		for i, e := range v {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+
+			if err := writeCanonical(buf, e); err != nil {
+				return err
+			}
+		}
+		buf.WriteByte(']')
+	case map[string]interface{}:
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+
+		// members are sorted by their UTF-16 code units, not their UTF-8 bytes
+		sort.Slice(keys, func(i, j int) bool {
+			return lessUTF16(keys[i], keys[j])
+		})
+
+		buf.WriteByte('{')
+		for i, k := range keys {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+
+			writeCanonicalString(buf, k)
+			buf.WriteByte(':')
+			if err := writeCanonical(buf, v[k]); err != nil {
+				return err
+			}
+		}
+		buf.WriteByte('}')
+	default:
+		return fmt.Errorf("unsupported canonical value %T", v)
+	}
+
+	return nil
+}
+
+// exactInteger returns false if the number is an integer, as written without a fraction
+// or exponent, that is changed by the conversion to the double f. Such numbers, e.g.
+// 64-bit ids decoded with UseNumber, would otherwise be rounded silently.
+func exactInteger(n json.Number, f float64) bool {
+	if strings.ContainsAny(string(n), ".eE") {
+		return true
+	}
+
+	i, ok := new(big.Int).SetString(string(n), 10)
+	if !ok {
+		return true
+	}
+
+	exact, _ := big.NewFloat(f).Int(nil)
+	return exact.Cmp(i) == 0
+}
+
+// canonicalNumber formats the number as ECMAScript's Number.prototype.toString does,
+// as required by RFC 8785.
+func canonicalNumber(f float64) string {
+	if f == 0 {
+		return "0"
+	}
+
+	format := byte('f')
+	if abs := math.Abs(f); abs < 1e-6 || abs >= 1e21 {
+		format = 'e'
+	}
+
+	s := strconv.FormatFloat(f, format, -1, 64)
+	if format == 'e' {
+		// clean up e-09 to e-9
+		n := len(s)
+		if n >= 4 && s[n-4] == 'e' && s[n-3] == '-' && s[n-2] == '0' {
+			s = s[:n-2] + s[n-1:]
+		}
+	}
+
+	return s
+}
+
+// writeCanonicalString writes the string escaping only quotes, backslashes and control characters.
+func writeCanonicalString(buf *bytes.Buffer, s string) {
+	const hex = "0123456789abcdef"
+
+	buf.WriteByte('"')
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch c {
+		case '"', '\\':
+			buf.WriteByte('\\')
+			buf.WriteByte(c)
+		case '\b':
+			buf.WriteString(`\b`)
+		case '\f':
+			buf.WriteString(`\f`)
+		case '\n':
+			buf.WriteString(`\n`)
+		case '\r':
+			buf.WriteString(`\r`)
+		case '\t':
+			buf.WriteString(`\t`)
+		default:
+			if c < 0x20 {
+				buf.WriteString(`\u00`)
+				buf.WriteByte(hex[c>>4])
+				buf.WriteByte(hex[c&0xF])
+			} else {
+				buf.WriteByte(c)
+			}
+		}
+	}
+	buf.WriteByte('"')
+}
+
+func lessUTF16(a, b string) bool {
+	ua := utf16.Encode([]rune(a))
+	ub := utf16.Encode([]rune(b))
+
+	for i := 0; i < len(ua) && i < len(ub); i++ {
+		if ua[i] != ub[i] {
+			return ua[i] < ub[i]
+		}
+	}
+
+	return len(ua) < len(ub)
+}
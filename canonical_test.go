@@ -0,0 +1,112 @@
+package geojson
+
+import (
+	"testing"
+)
+
+func TestMarshalCanonical(t *testing.T) {
+	f := NewPointFeature([]float64{1e21, 0.00000015})
+	f.ID = 123
+	f.SetProperty("name", "<a & b>")
+	f.SetProperty("nested", map[string]interface{}{"z": 1, "a": []interface{}{"€", "\n"}})
+	f.SetProperty("é", 4.50)
+
+	data, err := Marshal(f, Canonical())
+	if err != nil {
+		t.Fatalf("should marshal to json just fine but got %v", err)
+	}
+
+	expected := `{"geometry":{"coordinates":[1e+21,1.5e-7],"type":"Point"},"id":123,` +
+		`"properties":{"name":"<a & b>","nested":{"a":["` + "€" + `","\n"],"z":1},"` + "é" + `":4.5},"type":"Feature"}`
+	if string(data) != expected {
+		t.Errorf("incorrect json, got %v", string(data))
+	}
+}
+
+func TestCanonicalNumber(t *testing.T) {
+	cases := []struct {
+		value    float64
+		expected string
+	}{
+		{value: 0, expected: "0"},
+		{value: -0.0, expected: "0"},
+		{value: 333333333.33333329, expected: "333333333.3333333"},
+		{value: 1e30, expected: "1e+30"},
+		{value: 4.50, expected: "4.5"},
+		{value: 2e-3, expected: "0.002"},
+		{value: 0.000000000000000000000000001, expected: "1e-27"},
+		{value: -1.5e-7, expected: "-1.5e-7"},
+		{value: 9007199254740992, expected: "9007199254740992"},
+	}
+
+	for _, tc := range cases {
+		if v := canonicalNumber(tc.value); v != tc.expected {
+			t.Errorf("incorrect number for %v, got %v", tc.expected, v)
+		}
+	}
+}
+
+func TestLessUTF16(t *testing.T) {
+	// U+FB33 sorts after U+1D11E in UTF-8 but before it in UTF-16
+	if !lessUTF16("\U0001D11E", "דּ") {
+		t.Errorf("should sort by UTF-16 code units")
+	}
+}
+
+func TestHash(t *testing.T) {
+	a, err := UnmarshalFeature([]byte(`{"type":"Feature","properties":{"a":1,"b":"x"},"geometry":{"type":"Point","coordinates":[1.0,2]}}`))
+	if err != nil {
+		t.Fatalf("should unmarshal feature without issue, err %v", err)
+	}
+
+	b, err := UnmarshalFeature([]byte(`{"geometry":{"coordinates":[1,2.0],"type":"Point"},"properties":{"b":"x","a":1.0},"type":"Feature"}`))
+	if err != nil {
+		t.Fatalf("should unmarshal feature without issue, err %v", err)
+	}
+
+	ha, err := Hash(a)
+	if err != nil {
+		t.Fatalf("should hash without issue, err %v", err)
+	}
+
+	hb, _ := Hash(b)
+	if ha != hb {
+		t.Errorf("equivalent features should have the same hash")
+	}
+
+	b.SetProperty("a", 2)
+	if hb, _ = Hash(b); ha == hb {
+		t.Errorf("different features should have different hashes")
+	}
+}
+
+func TestHashLargeIntegers(t *testing.T) {
+	decode := func(id string) *Feature {
+		f, err := UnmarshalFeature([]byte(`{"type":"Feature","id":`+id+`,"properties":{},"geometry":null}`), UseNumber())
+		if err != nil {
+			t.Fatalf("should unmarshal feature without issue, err %v", err)
+		}
+		return f
+	}
+
+	if _, err := Hash(decode("9007199254740993")); err == nil {
+		t.Errorf("should return error for integer not exact as a double")
+	}
+
+	if _, err := Marshal(decode("9007199254740993"), Canonical()); err == nil {
+		t.Errorf("should return error for integer not exact as a double")
+	}
+
+	data, err := Marshal(decode("9007199254740992"), Canonical())
+	if err != nil {
+		t.Fatalf("should marshal exact integer, err %v", err)
+	}
+
+	if expected := `{"geometry":null,"id":9007199254740992,"properties":{},"type":"Feature"}`; string(data) != expected {
+		t.Errorf("incorrect json, got %s", data)
+	}
+
+	if _, err := Hash(decode("1.00000000000000001")); err != nil {
+		t.Errorf("should round non integers, err %v", err)
+	}
+}
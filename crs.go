@@ -0,0 +1,233 @@
+package geojson
+
+import (
+	"strconv"
+	"strings"
+)
+
+// CRSType is the type of a coordinate reference system object.
+type CRSType string
+
+// The coordinate reference system types of the 2008 GeoJSON spec.
+const (
+	CRSName CRSType = "name"
+	CRSLink CRSType = "link"
+)
+
+// DefaultCRS returns the coordinate reference system of objects without a crs member,
+// WGS 84 with longitude, latitude ordering. A new object is returned on each call.
+func DefaultCRS() *CRS {
+	return NewNamedCRS("urn:ogc:def:crs:OGC:1.3:CRS84")
+}
+
+// A CRS is a coordinate reference system object as defined by the 2008 GeoJSON spec.
+// RFC 7946 removed the crs member but it is still common in older data.
+// Use ParseCRS to read the crs member of a geometry, feature or feature collection
+// and Map to set it.
+type CRS struct {
+	Type CRSType
+
+	// Name identifies a named CRS, preferably an OGC URN such as urn:ogc:def:crs:EPSG::2263.
+	Name string
+
+	// Href is the URI of a linked CRS and LinkType its optional format,
+	// e.g. proj4, ogcwkt or esriwkt.
+	Href     string
+	LinkType string
+}
+
+// NewNamedCRS creates a CRS identified by name.
+func NewNamedCRS(name string) *CRS {
+	return &CRS{Type: CRSName, Name: name}
+}
+
+// NewLinkedCRS creates a CRS located at href. The link type is optional.
+func NewLinkedCRS(href, linkType string) *CRS {
+	return &CRS{Type: CRSLink, Href: href, LinkType: linkType}
+}
+
+// NewEPSGCRS creates a named CRS for the EPSG code using its OGC URN.
+func NewEPSGCRS(code int) *CRS {
+	return NewNamedCRS("urn:ogc:def:crs:EPSG::" + strconv.Itoa(code))
+}
+
+// ParseCRS converts the crs member of an object into a CRS.
+// A nil member returns a nil CRS. A *DecodeError is returned if the member is malformed.
+func ParseCRS(m map[string]interface{}) (*CRS, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	typ, ok := m["type"].(string)
+	if !ok {
+		return nil, &DecodeError{Path: "type", Expected: "string", Actual: memberType(m, "type")}
+	}
+
+	properties, ok := m["properties"].(map[string]interface{})
+	if !ok {
+		return nil, &DecodeError{Path: "properties", Expected: "object", Actual: memberType(m, "properties")}
+	}
+
+	switch CRSType(typ) {
+	case CRSName:
+		name, ok := properties["name"].(string)
+		if !ok {
+			return nil, &DecodeError{Path: "properties.name", Expected: "string", Actual: memberType(properties, "name")}
+		}
+
+		return NewNamedCRS(name), nil
+	case CRSLink:
+		href, ok := properties["href"].(string)
+		if !ok {
+			return nil, &DecodeError{Path: "properties.href", Expected: "string", Actual: memberType(properties, "href")}
+		}
+
+		linkType, ok := properties["type"].(string)
+		if !ok && properties["type"] != nil {
+			return nil, &DecodeError{Path: "properties.type", Expected: "string", Actual: memberType(properties, "type")}
+		}
+
+		return NewLinkedCRS(href, linkType), nil
+	}
+
+	return nil, &DecodeError{Path: "type", Expected: `"name" or "link"`, Actual: strconv.Quote(typ)}
+}
+
+// Map returns the crs member for the CRS, to be set as the CRS of an object.
+func (c *CRS) Map() map[string]interface{} {
+	properties := make(map[string]interface{})
+	switch c.Type {
+	case CRSName:
+		properties["name"] = c.Name
+	case CRSLink:
+		properties["href"] = c.Href
+		if c.LinkType != "" {
+			properties["type"] = c.LinkType
+		}
+	}
+
+	return map[string]interface{}{
+		"type":       string(c.Type),
+		"properties": properties,
+	}
+}
+
+// EPSG returns the EPSG code of a named CRS. The URN forms urn:ogc:def:crs:EPSG::2263
+// and urn:ogc:def:crs:EPSG:6.6:2263, with an optional version, as well as the
+// short EPSG:2263 form are supported.
+func (c *CRS) EPSG() (int, bool) {
+	if c == nil || c.Type != CRSName {
+		return 0, false
+	}
+
+	var code string
+	if rest, ok := cutPrefixFold(c.Name, "urn:ogc:def:crs:EPSG:"); ok {
+		i := strings.IndexByte(rest, ':')
+		if i < 0 {
+			return 0, false
+		}
+		code = rest[i+1:]
+	} else if rest, ok := cutPrefixFold(c.Name, "EPSG:"); ok {
+		code = rest
+	} else {
+		return 0, false
+	}
+
+	n, err := strconv.Atoi(code)
+	if err != nil || n <= 0 {
+		return 0, false
+	}
+
+	return n, true
+}
+
+// Equal returns true if the two CRSs are the same. Named CRSs with the same EPSG code are equal.
+func (c *CRS) Equal(o *CRS) bool {
+	if c == nil || o == nil {
+		return c == o
+	}
+
+	if a, ok := c.EPSG(); ok {
+		b, ok := o.EPSG()
+		return ok && a == b
+	}
+
+	return *c == *o
+}
+
+// EffectiveCRS returns the CRS of the last object given, taking into account
+// the crs members it inherits. The objects should be the path to the object starting
+// with the outermost, e.g. EffectiveCRS(fc, feature, feature.Geometry).
+// DefaultCRS() is returned if none of the objects have a crs member.
+func EffectiveCRS(path ...Object) (*CRS, error) {
+	for i := len(path) - 1; i >= 0; i-- {
+		o, ok := path[i].(interface{ crsMember() map[string]interface{} })
+		if !ok {
+			continue
+		}
+
+		crs, err := ParseCRS(o.crsMember())
+		if err != nil {
+			return nil, prefixPath(err, "crs")
+		}
+
+		if crs != nil {
+			return crs, nil
+		}
+	}
+
+	return DefaultCRS(), nil
+}
+
+func (g *Geometry) crsMember() map[string]interface{} {
+	if g == nil {
+		return nil
+	}
+	return g.CRS
+}
+
+func (f *Feature) crsMember() map[string]interface{} {
+	if f == nil {
+		return nil
+	}
+	return f.CRS
+}
+
+func (fc *FeatureCollection) crsMember() map[string]interface{} {
+	if fc == nil {
+		return nil
+	}
+	return fc.CRS
+}
+
+func (f *FeatureOf[P]) crsMember() map[string]interface{} {
+	if f == nil {
+		return nil
+	}
+	return f.CRS
+}
+
+func (fc *FeatureCollectionOf[P]) crsMember() map[string]interface{} {
+	if fc == nil {
+		return nil
+	}
+	return fc.CRS
+}
+
+// memberType returns the JSON type of a member of a decoded object, missing if it is not present.
+func memberType(m map[string]interface{}, key string) string {
+	v, ok := m[key]
+	if !ok {
+		return "missing"
+	}
+
+	return jsonType(v)
+}
+
+func cutPrefixFold(s, prefix string) (string, bool) {
+	if len(s) < len(prefix) || !strings.EqualFold(s[:len(prefix)], prefix) {
+		return s, false
+	}
+
+	return s[len(prefix):], true
+}
@@ -0,0 +1,160 @@
+package geojson
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestParseCRS(t *testing.T) {
+	f, err := UnmarshalFeature([]byte(`{"type": "Feature", "geometry": null,
+		"crs": {"type": "name", "properties": {"name": "urn:ogc:def:crs:EPSG::2263"}}}`))
+	if err != nil {
+		t.Fatalf("should unmarshal feature without issue, err %v", err)
+	}
+
+	crs, err := ParseCRS(f.CRS)
+	if err != nil {
+		t.Fatalf("should parse crs, got %v", err)
+	}
+
+	if !reflect.DeepEqual(crs, NewNamedCRS("urn:ogc:def:crs:EPSG::2263")) {
+		t.Errorf("incorrect crs, got %v", crs)
+	}
+
+	if !reflect.DeepEqual(crs.Map(), f.CRS) {
+		t.Errorf("should convert back to the same map, got %v", crs.Map())
+	}
+
+	link := NewLinkedCRS("http://example.com/crs/42", "proj4")
+	crs, err = ParseCRS(link.Map())
+	if err != nil || !reflect.DeepEqual(crs, link) {
+		t.Errorf("should parse linked crs, got %v %v", crs, err)
+	}
+
+	if crs, err := ParseCRS(nil); crs != nil || err != nil {
+		t.Errorf("should return nil for no crs, got %v %v", crs, err)
+	}
+}
+
+func TestParseCRSErrors(t *testing.T) {
+	cases := []struct {
+		name    string
+		crs     map[string]interface{}
+		message string
+	}{
+		{
+			name:    "missing type",
+			crs:     map[string]interface{}{"properties": map[string]interface{}{}},
+			message: "type: expected string, got missing",
+		},
+		{
+			name:    "unknown type",
+			crs:     map[string]interface{}{"type": "EPSG", "properties": map[string]interface{}{"code": 4326.0}},
+			message: `type: expected "name" or "link", got "EPSG"`,
+		},
+		{
+			name:    "properties",
+			crs:     map[string]interface{}{"type": "name", "properties": "EPSG:4326"},
+			message: "properties: expected object, got string",
+		},
+		{
+			name:    "name",
+			crs:     map[string]interface{}{"type": "name", "properties": map[string]interface{}{"name": 4326.0}},
+			message: "properties.name: expected string, got number",
+		},
+		{
+			name:    "href",
+			crs:     map[string]interface{}{"type": "link", "properties": map[string]interface{}{}},
+			message: "properties.href: expected string, got missing",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := ParseCRS(tc.crs)
+
+			var de *DecodeError
+			if !errors.As(err, &de) {
+				t.Fatalf("should return decode error, got %v", err)
+			}
+
+			if err.Error() != tc.message {
+				t.Errorf("incorrect error message, got %v", err)
+			}
+		})
+	}
+}
+
+func TestCRSEPSG(t *testing.T) {
+	cases := []struct {
+		name string
+		code int
+		ok   bool
+	}{
+		{name: "urn:ogc:def:crs:EPSG::2263", code: 2263, ok: true},
+		{name: "urn:ogc:def:crs:EPSG:6.6:4326", code: 4326, ok: true},
+		{name: "URN:OGC:DEF:CRS:EPSG::3857", code: 3857, ok: true},
+		{name: "EPSG:4326", code: 4326, ok: true},
+		{name: "urn:ogc:def:crs:OGC:1.3:CRS84"},
+		{name: "urn:ogc:def:crs:EPSG::abc"},
+		{name: "urn:ogc:def:crs:EPSG:2263"},
+	}
+
+	for _, tc := range cases {
+		code, ok := NewNamedCRS(tc.name).EPSG()
+		if code != tc.code || ok != tc.ok {
+			t.Errorf("%s: incorrect code, got %v %v", tc.name, code, ok)
+		}
+	}
+
+	if code, ok := NewEPSGCRS(2263).EPSG(); !ok || code != 2263 {
+		t.Errorf("should round trip code, got %v %v", code, ok)
+	}
+
+	if !NewEPSGCRS(4326).Equal(NewNamedCRS("EPSG:4326")) {
+		t.Errorf("should be equal by code")
+	}
+
+	if NewEPSGCRS(4326).Equal(DefaultCRS()) {
+		t.Errorf("should not be equal to default")
+	}
+}
+
+func TestEffectiveCRS(t *testing.T) {
+	fc := NewFeatureCollection()
+	fc.CRS = NewEPSGCRS(2263).Map()
+
+	f := NewPointFeature([]float64{1, 2})
+	fc.AddFeature(f)
+
+	crs, err := EffectiveCRS(fc, f, f.Geometry)
+	if err != nil {
+		t.Fatalf("should not return error, got %v", err)
+	}
+
+	if code, _ := crs.EPSG(); code != 2263 {
+		t.Errorf("should inherit crs from collection, got %v", crs)
+	}
+
+	f.CRS = NewEPSGCRS(4326).Map()
+	if crs, _ := EffectiveCRS(fc, f); !crs.Equal(NewEPSGCRS(4326)) {
+		t.Errorf("should use feature crs, got %v", crs)
+	}
+
+	crs, _ = EffectiveCRS(NewFeature(nil))
+	if !crs.Equal(DefaultCRS()) {
+		t.Errorf("should return default crs, got %v", crs)
+	}
+
+	crs.Name = "EPSG:2263"
+	if DefaultCRS().Name != "urn:ogc:def:crs:OGC:1.3:CRS84" {
+		t.Errorf("should not be able to modify the default crs")
+	}
+
+	f.Geometry.CRS = map[string]interface{}{"type": "name"}
+	_, err = EffectiveCRS(fc, f, f.Geometry)
+	if err == nil || err.Error() != "crs.properties: expected object, got missing" {
+		t.Errorf("should return error for malformed crs, got %v", err)
+	}
+}
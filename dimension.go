@@ -0,0 +1,206 @@
+package geojson
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrMixedDimensions is returned when the positions of a geometry do not all
+// have the same number of elements.
+var ErrMixedDimensions = errors.New("mixed coordinate dimensions")
+
+// Dimension returns the number of elements of the positions of the geometry,
+// 2 for x, y and 3 for x, y, z. Zero is returned for an empty geometry.
+// An error wrapping ErrMixedDimensions is returned if the positions differ,
+// including between the members of a geometry collection.
+func (g *Geometry) Dimension() (int, error) {
+	dimension := 0
+	var err error
+
+	eachPosition(g, func(p []float64) {
+		if err != nil {
+			return
+		}
+
+		switch {
+		case dimension == 0:
+			dimension = len(p)
+		case len(p) != dimension:
+			err = fmt.Errorf("%w: %d and %d", ErrMixedDimensions, dimension, len(p))
+		}
+	})
+
+	if err != nil {
+		return 0, err
+	}
+
+	return dimension, nil
+}
+
+// Force2D removes all but the x and y elements of every position.
+func (g *Geometry) Force2D() {
+	g.SetDimension(2, 0)
+}
+
+// Force3D makes every position x, y, z. Missing z values are set to fill
+// and any extra elements are removed.
+func (g *Geometry) Force3D(fill float64) {
+	g.SetDimension(3, fill)
+}
+
+// SetDimension makes every position have n elements, appending fill values
+// or removing the extra elements as needed. The bounding boxes are updated
+// to match, along with the members of a geometry collection.
+// Positions need at least x and y, so n less than 2 is ignored.
+func (g *Geometry) SetDimension(n int, fill float64) {
+	setDimension(g, n, fill, false)
+}
+
+// PadDimension appends fill values to positions with less than n elements.
+// Unlike SetDimension, extra elements, such as a measure, are retained.
+func (g *Geometry) PadDimension(n int, fill float64) {
+	setDimension(g, n, fill, true)
+}
+
+func setDimension(g *Geometry, n int, fill float64, retain bool) {
+	if g == nil || n < 2 {
+		return
+	}
+
+	if d := len(g.BoundingBox) / 2; d != 0 && len(g.BoundingBox)%2 == 0 && (d < n || (d > n && !retain)) {
+		g.BoundingBox = append(
+			resizePosition(g.BoundingBox[:d], n, fill, retain),
+			resizePosition(g.BoundingBox[d:], n, fill, retain)...,
+		)
+	}
+
+	if g.Type == GeometryCollection {
+		for _, c := range g.Geometries {
+			setDimension(c, n, fill, retain)
+		}
+		return
+	}
+
+	mapPositions(g, func(p []float64) []float64 {
+		return resizePosition(p, n, fill, retain)
+	})
+}
+
+// resizePosition returns a copy of the position with n elements.
+// Extra elements are kept if retain is set. Empty positions are left empty.
+func resizePosition(p []float64, n int, fill float64, retain bool) []float64 {
+	if len(p) == 0 || len(p) == n || (len(p) > n && retain) {
+		return p
+	}
+
+	r := make([]float64, n)
+	copy(r, p)
+	for i := len(p); i < n; i++ {
+		r[i] = fill
+	}
+
+	return r
+}
+
+// eachPosition calls fn with every position of the geometry, including those of the
+// members of a geometry collection. Empty positions are skipped.
+func eachPosition(g *Geometry, fn func(p []float64)) {
+	if g == nil {
+		return
+	}
+
+	visit := func(p []float64) {
+		if len(p) != 0 {
+			fn(p)
+		}
+	}
+
+	switch g.Type {
+	case GeometryPoint:
+		visit(g.Point)
+	case GeometryMultiPoint:
+		for _, p := range g.MultiPoint {
+			visit(p)
+		}
+	case GeometryLineString:
+		for _, p := range g.LineString {
+			visit(p)
+		}
+	case GeometryMultiLineString:
+		for _, ls := range g.MultiLineString {
+			for _, p := range ls {
+				visit(p)
+			}
+		}
+	case GeometryPolygon:
+		for _, ring := range g.Polygon {
+			for _, p := range ring {
+				visit(p)
+			}
+		}
+	case GeometryMultiPolygon:
+		for _, polygon := range g.MultiPolygon {
+			for _, ring := range polygon {
+				for _, p := range ring {
+					visit(p)
+				}
+			}
+		}
+	case GeometryCollection:
+		for _, c := range g.Geometries {
+			eachPosition(c, fn)
+		}
+	}
+}
+
+// mapPositions replaces every position of the geometry, but not those of the members
+// of a geometry collection, with the result of fn. The position sets are copied
+// so that slices shared with other geometries are not modified.
+func mapPositions(g *Geometry, fn func(p []float64) []float64) {
+	mapSet := func(ps [][]float64) [][]float64 {
+		if ps == nil {
+			return nil
+		}
+
+		r := make([][]float64, len(ps))
+		for i, p := range ps {
+			r[i] = fn(p)
+		}
+		return r
+	}
+
+	mapPaths := func(paths [][][]float64) [][][]float64 {
+		if paths == nil {
+			return nil
+		}
+
+		r := make([][][]float64, len(paths))
+		for i, ps := range paths {
+			r[i] = mapSet(ps)
+		}
+		return r
+	}
+
+	switch g.Type {
+	case GeometryPoint:
+		if g.Point != nil {
+			g.Point = fn(g.Point)
+		}
+	case GeometryMultiPoint:
+		g.MultiPoint = mapSet(g.MultiPoint)
+	case GeometryLineString:
+		g.LineString = mapSet(g.LineString)
+	case GeometryMultiLineString:
+		g.MultiLineString = mapPaths(g.MultiLineString)
+	case GeometryPolygon:
+		g.Polygon = mapPaths(g.Polygon)
+	case GeometryMultiPolygon:
+		if g.MultiPolygon != nil {
+			polygons := make([][][][]float64, len(g.MultiPolygon))
+			for i, polygon := range g.MultiPolygon {
+				polygons[i] = mapPaths(polygon)
+			}
+			g.MultiPolygon = polygons
+		}
+	}
+}
@@ -0,0 +1,117 @@
+package geojson
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestGeometryDimension(t *testing.T) {
+	cases := []struct {
+		name      string
+		geometry  *Geometry
+		dimension int
+		mixed     bool
+	}{
+		{"point", NewPointGeometry([]float64{1, 2}), 2, false},
+		{"3d line string", NewLineStringGeometry([][]float64{{1, 2, 3}, {3, 4, 5}}), 3, false},
+		{"empty", NewEmptyGeometry(GeometryPolygon), 0, false},
+		{"mixed line string", NewLineStringGeometry([][]float64{{1, 2, 3}, {3, 4}}), 0, true},
+		{"mixed polygon rings", NewPolygonGeometry([][][]float64{
+			{{0, 0, 1}, {1, 0, 1}, {1, 1, 1}, {0, 0, 1}},
+			{{0, 0}, {1, 0}, {1, 1}, {0, 0}},
+		}), 0, true},
+		{"mixed collection", NewCollectionGeometry(
+			NewPointGeometry([]float64{1, 2}),
+			NewPointGeometry([]float64{1, 2, 3}),
+		), 0, true},
+		{"collection with empty", NewCollectionGeometry(
+			NewEmptyGeometry(GeometryPoint),
+			NewPointGeometry([]float64{1, 2, 3}),
+		), 3, false},
+	}
+
+	for _, tc := range cases {
+		d, err := tc.geometry.Dimension()
+		if tc.mixed {
+			if !errors.Is(err, ErrMixedDimensions) {
+				t.Errorf("%s: should return mixed dimensions error, got %v", tc.name, err)
+			}
+			continue
+		}
+
+		if err != nil || d != tc.dimension {
+			t.Errorf("%s: incorrect dimension, got %v %v", tc.name, d, err)
+		}
+	}
+}
+
+func TestGeometryForce2D(t *testing.T) {
+	ring := [][]float64{{0, 0, 1}, {1, 0, 2}, {1, 1, 3}, {0, 0, 1}}
+	g := NewCollectionGeometry(
+		NewPolygonGeometry([][][]float64{ring}),
+		NewPointGeometry([]float64{1, 2}),
+	)
+	g.Geometries[0].BoundingBox = []float64{0, 0, 1, 1, 1, 3}
+
+	g.Force2D()
+
+	if d, err := g.Dimension(); d != 2 || err != nil {
+		t.Errorf("should be 2d, got %v %v", d, err)
+	}
+
+	if !reflect.DeepEqual(g.Geometries[0].BoundingBox, []float64{0, 0, 1, 1}) {
+		t.Errorf("incorrect bbox, got %v", g.Geometries[0].BoundingBox)
+	}
+
+	if len(ring[1]) != 3 {
+		t.Errorf("should not modify the original positions")
+	}
+}
+
+func TestGeometryForce3D(t *testing.T) {
+	g := NewMultiPolygonGeometry([][][]float64{{{0, 0}, {1, 0, 5, 7}, {1, 1}, {0, 0}}})
+	g.BoundingBox = []float64{0, 0, 1, 1}
+
+	g.Force3D(10)
+
+	expected := [][][][]float64{{{{0, 0, 10}, {1, 0, 5}, {1, 1, 10}, {0, 0, 10}}}}
+	if !reflect.DeepEqual(g.MultiPolygon, expected) {
+		t.Errorf("incorrect coordinates, got %v", g.MultiPolygon)
+	}
+
+	if !reflect.DeepEqual(g.BoundingBox, []float64{0, 0, 10, 1, 1, 10}) {
+		t.Errorf("incorrect bbox, got %v", g.BoundingBox)
+	}
+}
+
+func TestGeometryPadDimension(t *testing.T) {
+	g := NewLineStringGeometry([][]float64{{0, 0}, {1, 0, 5, 7}})
+	g.PadDimension(3, 0)
+
+	expected := [][]float64{{0, 0, 0}, {1, 0, 5, 7}}
+	if !reflect.DeepEqual(g.LineString, expected) {
+		t.Errorf("should retain extra elements, got %v", g.LineString)
+	}
+
+	if _, err := g.Dimension(); !errors.Is(err, ErrMixedDimensions) {
+		t.Errorf("should still be mixed, got %v", err)
+	}
+}
+
+func TestGeometrySetDimensionInvalid(t *testing.T) {
+	g := NewLineStringGeometry([][]float64{{0, 0, 1}, {1, 0, 2}})
+	g.BoundingBox = []float64{0, 0, 1, 1, 0, 2}
+
+	for _, n := range []int{-1, 0, 1} {
+		g.SetDimension(n, 0)
+	}
+
+	if !reflect.DeepEqual(g.LineString, [][]float64{{0, 0, 1}, {1, 0, 2}}) {
+		t.Errorf("should ignore dimensions less than 2, got %v", g.LineString)
+	}
+
+	if len(g.BoundingBox) != 6 {
+		t.Errorf("should not change bbox, got %v", g.BoundingBox)
+	}
+}
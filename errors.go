@@ -0,0 +1,121 @@
+package geojson
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+// A DecodeError describes a value of the input that does not match
+// the GeoJSON structure expected at its location.
+// Use errors.As to retrieve it from the errors returned while decoding.
+type DecodeError struct {
+	// Path is the location of the value within the input,
+	// e.g. features[1832].geometry.coordinates[0][14][1]
+	Path string
+
+	// Expected describes the kind of value expected at the location, e.g. number or position.
+	Expected string
+
+	// Actual describes the value found, usually its JSON type, e.g. string or null.
+	// It is missing if a required member is not present.
+	Actual string
+}
+
+func (e *DecodeError) Error() string {
+	if e.Path == "" {
+		return fmt.Sprintf("expected %s, got %s", e.Expected, e.Actual)
+	}
+
+	return fmt.Sprintf("%s: expected %s, got %s", e.Path, e.Expected, e.Actual)
+}
+
+// prefixPath prepends the location of the parent value to the path of a *DecodeError.
+// Other errors are returned unchanged.
+func prefixPath(err error, prefix string) error {
+	de, ok := err.(*DecodeError)
+	if !ok {
+		return err
+	}
+
+	switch {
+	case de.Path == "":
+		de.Path = prefix
+	case de.Path[0] == '[':
+		de.Path = prefix + de.Path
+	default:
+		de.Path = prefix + "." + de.Path
+	}
+
+	return de
+}
+
+// prefixIndex prepends an array index to the path of a *DecodeError.
+func prefixIndex(err error, i int) error {
+	return prefixPath(err, "["+strconv.Itoa(i)+"]")
+}
+
+// jsonType returns the JSON type of a decoded value or a json.Token.
+func jsonType(v interface{}) string {
+	switch v {
+	case json.Delim('{'):
+		return "object"
+	case json.Delim('['):
+		return "array"
+	}
+
+	switch v.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case float64, json.Number:
+		return "number"
+	case string:
+		return "string"
+	case []interface{}:
+		return "array"
+	case map[string]interface{}:
+		return "object"
+	}
+
+	return fmt.Sprintf("%T", v)
+}
+
+// rawType returns the JSON type of the raw, valid, JSON value.
+func rawType(data []byte) string {
+	data = bytes.TrimSpace(data)
+	if len(data) == 0 {
+		return "missing"
+	}
+
+	switch data[0] {
+	case '{':
+		return "object"
+	case '[':
+		return "array"
+	case '"':
+		return "string"
+	case 't', 'f':
+		return "boolean"
+	case 'n':
+		return "null"
+	}
+
+	return "number"
+}
+
+// isNull returns true if the raw JSON value is null.
+func isNull(data []byte) bool {
+	return bytes.Equal(bytes.TrimSpace(data), []byte("null"))
+}
+
+// count formats a number of things, e.g. 1 position or 3 positions.
+func count(n int, noun string) string {
+	if n == 1 {
+		return "1 " + noun
+	}
+
+	return strconv.Itoa(n) + " " + noun + "s"
+}
@@ -0,0 +1,116 @@
+package geojson
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestDecodeErrorPath(t *testing.T) {
+	cases := []struct {
+		name     string
+		data     string
+		path     string
+		expected string
+		actual   string
+	}{
+		{
+			name: "coordinate",
+			data: `{"type": "FeatureCollection", "features": [
+				{"type": "Feature", "geometry": null, "properties": {}},
+				{"type": "Feature", "geometry": {"type": "Polygon", "coordinates": [[[1, 2], [3, "4"]]]}}
+			]}`,
+			path:     "features[1].geometry.coordinates[0][1][1]",
+			expected: "number",
+			actual:   "string",
+		},
+		{
+			name: "feature bbox",
+			data: `{"type": "FeatureCollection", "features": [
+				{"type": "Feature", "bbox": [1, 2, null, 4], "geometry": null}
+			]}`,
+			path:     "features[0].bbox[2]",
+			expected: "number",
+			actual:   "null",
+		},
+		{
+			name: "collection geometry",
+			data: `{"type": "FeatureCollection", "features": [
+				{"type": "Feature", "geometry": {"type": "GeometryCollection", "geometries": [
+					{"type": "Point", "coordinates": [1, 2]},
+					{"type": "LineString", "coordinates": {}}
+				]}}
+			]}`,
+			path:     "features[0].geometry.geometries[1].coordinates",
+			expected: "array of positions",
+			actual:   "object",
+		},
+		{
+			name:     "feature",
+			data:     `{"type": "FeatureCollection", "features": [[1, 2]]}`,
+			path:     "features[0]",
+			expected: "feature object",
+			actual:   "array",
+		},
+		{
+			name:     "collection bbox",
+			data:     `{"type": "FeatureCollection", "bbox": "1,2,3,4", "features": []}`,
+			path:     "bbox",
+			expected: "bbox array",
+			actual:   "string",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := UnmarshalFeatureCollection([]byte(tc.data))
+
+			var de *DecodeError
+			if !errors.As(err, &de) {
+				t.Fatalf("should return decode error, got %v", err)
+			}
+
+			if de.Path != tc.path {
+				t.Errorf("incorrect path, got %v", de.Path)
+			}
+
+			if de.Expected != tc.expected {
+				t.Errorf("incorrect expected, got %v", de.Expected)
+			}
+
+			if de.Actual != tc.actual {
+				t.Errorf("incorrect actual, got %v", de.Actual)
+			}
+
+			// the same error should be returned by the standard library
+			fc := &FeatureCollection{}
+			if err := json.Unmarshal([]byte(tc.data), fc); !errors.As(err, &de) || de.Path != tc.path {
+				t.Errorf("json.Unmarshal should return the same error, got %v", err)
+			}
+		})
+	}
+}
+
+func TestDecodeErrorStrictPath(t *testing.T) {
+	rawJSON := `{"type": "FeatureCollection", "features": [
+		{"type": "Feature", "geometry": {"type": "MultiPolygon", "coordinates": [
+			[[[0, 0], [1, 0], [1, 1], [0, 0]]],
+			[[[0, 0], [1, 0], [1, 1], [0, 0]], [[0, 0], [1, 0], [1, 1], [0, 1]]]
+		]}}
+	]}`
+
+	_, err := UnmarshalFeatureCollection([]byte(rawJSON), Strict())
+
+	var de *DecodeError
+	if !errors.As(err, &de) {
+		t.Fatalf("should return decode error, got %v", err)
+	}
+
+	if de.Path != "features[0].geometry.coordinates[1][1]" {
+		t.Errorf("incorrect path, got %v", de.Path)
+	}
+
+	if err.Error() != "features[0].geometry.coordinates[1][1]: expected closed linear ring, got unclosed linear ring" {
+		t.Errorf("incorrect error message, got %v", err)
+	}
+}
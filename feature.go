@@ -2,6 +2,7 @@ package geojson
 
 import (
 	"encoding/json"
+	"fmt"
 )
 
 // A Feature corresponds to GeoJSON feature object
@@ -11,7 +12,15 @@ type Feature struct {
 	BoundingBox []float64              `json:"bbox,omitempty"`
 	Geometry    *Geometry              `json:"geometry"`
 	Properties  map[string]interface{} `json:"properties"`
-	CRS         map[string]interface{} `json:"crs,omitempty"` // Coordinate Reference System Objects are not currently supported
+	CRS         map[string]interface{} `json:"crs,omitempty"` // Coordinate Reference System Object, see ParseCRS
+
+	// NullProperties is set when decoding "properties": null. When marshaling,
+	// empty properties are written as null instead of {} if it is set.
+	NullProperties bool `json:"-"`
+
+	// ForeignMembers holds the members of the feature object not defined by the GeoJSON spec.
+	// They are written after the other members when marshaling.
+	ForeignMembers map[string]interface{} `json:"-"`
 }
 
 // NewFeature creates and initializes a GeoJSON feature given the required attributes.
@@ -58,6 +67,34 @@ func NewCollectionFeature(geometries ...*Geometry) *Feature {
 	return NewFeature(NewCollectionGeometry(geometries...))
 }
 
+// IDString returns the id of the feature if it is a string.
+func (f *Feature) IDString() (string, error) {
+	if s, ok := f.ID.(string); ok {
+		return s, nil
+	}
+
+	return "", fmt.Errorf("type assertion of id %v to string failed", f.ID)
+}
+
+// IDInt64 returns the id of the feature if it is an integer number.
+// Use the UseNumber option when decoding to read ids larger than 2^53 exactly.
+func (f *Feature) IDInt64() (int64, error) {
+	if i, ok := toInt64(f.ID); ok {
+		return i, nil
+	}
+
+	return 0, fmt.Errorf("type assertion of id %v to int64 failed", f.ID)
+}
+
+// IDFloat64 returns the id of the feature if it is a number.
+func (f *Feature) IDFloat64() (float64, error) {
+	if i, ok := toFloat64(f.ID); ok {
+		return i, nil
+	}
+
+	return 0, fmt.Errorf("type assertion of id %v to float64 failed", f.ID)
+}
+
 // MarshalJSON converts the feature object into the proper JSON.
 // It will handle the encoding of all the child geometries.
 // Alternately one can call json.Marshal(f) directly for the same result.
@@ -75,24 +112,136 @@ func (f Feature) MarshalJSON() ([]byte, error) {
 	}
 	if f.Properties != nil && len(f.Properties) != 0 {
 		fea.Properties = f.Properties
-	} else {
+	} else if !f.NullProperties {
 		fea.Properties = make(map[string]interface{})
 	}
 	if f.CRS != nil && len(f.CRS) != 0 {
 		fea.CRS = f.CRS
 	}
 
-	return json.Marshal(fea)
+	data, err := json.Marshal(fea)
+	if err != nil {
+		return nil, err
+	}
+
+	return appendForeignMembers(data, f.ForeignMembers, featureMembers)
 }
 
 // UnmarshalFeature decodes the data into a GeoJSON feature.
-// Alternately one can call json.Unmarshal(f) directly for the same result.
-func UnmarshalFeature(data []byte) (*Feature, error) {
+// Alternately one can call json.Unmarshal(f) directly for the same result
+// when no options are needed.
+func UnmarshalFeature(data []byte, opts ...UnmarshalOption) (*Feature, error) {
 	f := &Feature{}
-	err := json.Unmarshal(data, f)
+	err := decodeFeature(f, data, newUnmarshalOptions(opts))
 	if err != nil {
 		return nil, err
 	}
 
 	return f, nil
 }
+
+// UnmarshalJSON decodes the data into a GeoJSON feature.
+// This fulfills the json.Unmarshaler interface.
+func (f *Feature) UnmarshalJSON(data []byte) error {
+	return decodeFeature(f, data, &unmarshalOptions{})
+}
+
+func decodeFeature(f *Feature, data []byte, o *unmarshalOptions) error {
+	object, err := decodeFeatureObject(data)
+	if err != nil || object == nil {
+		return err
+	}
+
+	if raw, ok := object["properties"]; ok {
+		f.Properties = nil
+		f.NullProperties = isNull(raw)
+		if err := o.unmarshalValue(raw, &f.Properties); err != nil {
+			return &DecodeError{Path: "properties", Expected: "object", Actual: rawType(raw)}
+		}
+	}
+
+	return decodeFeatureMembers(f, object, o)
+}
+
+// decodeFeatureObject splits the feature object into its members.
+// A nil object is returned for null.
+func decodeFeatureObject(data []byte) (map[string]json.RawMessage, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	var object map[string]json.RawMessage
+	err := json.Unmarshal(data, &object)
+	if _, ok := err.(*json.UnmarshalTypeError); ok {
+		return nil, &DecodeError{Expected: "feature object", Actual: rawType(data)}
+	}
+
+	return object, err
+}
+
+// decodeFeatureMembers decodes all the members of the feature object except the properties.
+func decodeFeatureMembers(f *Feature, object map[string]json.RawMessage, o *unmarshalOptions) error {
+	if raw, ok := object["id"]; ok {
+		if err := o.unmarshalValue(raw, &f.ID); err != nil {
+			return err
+		}
+	}
+
+	if raw, ok := object["type"]; ok {
+		if err := json.Unmarshal(raw, &f.Type); err != nil {
+			return &DecodeError{Path: "type", Expected: "string", Actual: rawType(raw)}
+		}
+	}
+
+	if raw, ok := object["bbox"]; ok {
+		var bb interface{}
+		if err := json.Unmarshal(raw, &bb); err != nil {
+			return err
+		}
+
+		var err error
+		f.BoundingBox, err = decodeBoundingBox(bb)
+		if err != nil {
+			return prefixPath(err, "bbox")
+		}
+	}
+
+	if raw, ok := object["geometry"]; ok {
+		f.Geometry = nil
+		if !isNull(raw) {
+			f.Geometry = &Geometry{}
+			if err := decodeGeometry(f.Geometry, raw); err != nil {
+				return prefixPath(err, "geometry")
+			}
+		}
+	}
+
+	if raw, ok := object["crs"]; ok {
+		f.CRS = nil
+		if err := json.Unmarshal(raw, &f.CRS); err != nil {
+			return &DecodeError{Path: "crs", Expected: "object", Actual: rawType(raw)}
+		}
+	}
+
+	for k, raw := range object {
+		if featureMembers[k] {
+			continue
+		}
+
+		var v interface{}
+		if err := o.unmarshalValue(raw, &v); err != nil {
+			return err
+		}
+
+		if f.ForeignMembers == nil {
+			f.ForeignMembers = make(map[string]interface{})
+		}
+		f.ForeignMembers[k] = v
+	}
+
+	if o.strict {
+		return checkStrictFeature(f, o)
+	}
+
+	return nil
+}
@@ -6,7 +6,10 @@ such as `UnmarshalFeatureCollection`, `UnmarshalFeature` and `UnmarshalGeometry`
 package geojson
 
 import (
+	"bytes"
 	"encoding/json"
+	"errors"
+	"io"
 )
 
 // A FeatureCollection correlates to a GeoJSON feature collection.
@@ -14,7 +17,11 @@ type FeatureCollection struct {
 	Type        string                 `json:"type"`
 	BoundingBox []float64              `json:"bbox,omitempty"`
 	Features    []*Feature             `json:"features"`
-	CRS         map[string]interface{} `json:"crs,omitempty"` // Coordinate Reference System Objects are not currently supported
+	CRS         map[string]interface{} `json:"crs,omitempty"` // Coordinate Reference System Object, see ParseCRS
+
+	// ForeignMembers holds the members of the feature collection object not defined by the GeoJSON spec.
+	// They are written after the other members when marshaling.
+	ForeignMembers map[string]interface{} `json:"-"`
 }
 
 // NewFeatureCollection creates and initializes a new feature collection.
@@ -54,17 +61,68 @@ func (fc FeatureCollection) MarshalJSON() ([]byte, error) {
 		fcol.CRS = fc.CRS
 	}
 
-	return json.Marshal(fcol)
+	data, err := json.Marshal(fcol)
+	if err != nil {
+		return nil, err
+	}
+
+	return appendForeignMembers(data, fc.ForeignMembers, featureCollectionMembers)
 }
 
 // UnmarshalFeatureCollection decodes the data into a GeoJSON feature collection.
-// Alternately one can call json.Unmarshal(fc) directly for the same result.
-func UnmarshalFeatureCollection(data []byte) (*FeatureCollection, error) {
+// Alternately one can call json.Unmarshal(fc) directly for the same result
+// when no options are needed.
+func UnmarshalFeatureCollection(data []byte, opts ...UnmarshalOption) (*FeatureCollection, error) {
 	fc := &FeatureCollection{}
-	err := json.Unmarshal(data, fc)
+	err := decodeFeatureCollection(fc, data, newUnmarshalOptions(opts))
 	if err != nil {
 		return nil, err
 	}
 
 	return fc, nil
 }
+
+// UnmarshalJSON decodes the data into a GeoJSON feature collection.
+// This fulfills the json.Unmarshaler interface.
+func (fc *FeatureCollection) UnmarshalJSON(data []byte) error {
+	return decodeFeatureCollection(fc, data, &unmarshalOptions{})
+}
+
+// decodeFeatureCollection reads the collection using the streaming decoder
+// so that the location of any invalid feature can be reported.
+func decodeFeatureCollection(fc *FeatureCollection, data []byte, o *unmarshalOptions) error {
+	if isNull(data) {
+		return nil
+	}
+
+	d := &FeatureCollectionDecoder{
+		dec:  json.NewDecoder(bytes.NewReader(data)),
+		opts: o,
+	}
+
+	features := make([]*Feature, 0)
+	for {
+		f, err := d.Next()
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			return err
+		}
+
+		features = append(features, f)
+	}
+
+	if _, err := d.dec.Token(); err != io.EOF {
+		return errors.New("invalid data after feature collection object")
+	}
+
+	fc.Type = d.typ
+	fc.BoundingBox = d.boundingBox
+	fc.Features = features
+	fc.CRS = d.crs
+	fc.ForeignMembers = d.foreignMembers
+
+	return nil
+}
@@ -105,3 +105,26 @@ func TestFeatureCollectionMarshalValue(t *testing.T) {
 		t.Errorf("json should set features object to at least empty array")
 	}
 }
+
+func BenchmarkUnmarshalFeatureCollection(b *testing.B) {
+	fc := NewFeatureCollection()
+	for i := 0; i < 1000; i++ {
+		f := NewLineStringFeature([][]float64{{float64(i), 1}, {float64(i) + 0.5, 2}, {float64(i) + 1, 3}})
+		f.SetProperty("index", i)
+		f.SetProperty("name", "feature")
+		fc.AddFeature(f)
+	}
+
+	data, err := fc.MarshalJSON()
+	if err != nil {
+		b.Fatalf("should marshal feature collection, got %v", err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := UnmarshalFeatureCollection(data); err != nil {
+			b.Fatalf("should unmarshal feature collection, got %v", err)
+		}
+	}
+}
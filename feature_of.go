@@ -0,0 +1,440 @@
+package geojson
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+)
+
+// A FeatureOf is a GeoJSON feature whose properties are decoded into the type P,
+// usually a struct with json tags, instead of a map.
+// It is encoded and decoded following the same rules as Feature.
+type FeatureOf[P any] struct {
+	ID          interface{}            `json:"id,omitempty"`
+	Type        string                 `json:"type"`
+	BoundingBox []float64              `json:"bbox,omitempty"`
+	Geometry    *Geometry              `json:"geometry"`
+	Properties  P                      `json:"properties"`
+	CRS         map[string]interface{} `json:"crs,omitempty"` // Coordinate Reference System Object, see ParseCRS
+
+	// NullProperties is set when decoding "properties": null. When marshaling,
+	// properties that encode to null or {} are written as null if it is set.
+	NullProperties bool `json:"-"`
+
+	// ForeignMembers holds the members of the feature object not defined by the GeoJSON spec.
+	// They are written after the other members when marshaling.
+	ForeignMembers map[string]interface{} `json:"-"`
+}
+
+// NewFeatureOf creates and initializes a GeoJSON feature with typed properties.
+func NewFeatureOf[P any](geometry *Geometry, properties P) *FeatureOf[P] {
+	return &FeatureOf[P]{
+		Type:       "Feature",
+		Geometry:   geometry,
+		Properties: properties,
+	}
+}
+
+// ToFeatureOf converts the feature into one with properties of type P.
+// The properties are converted using their JSON encoding.
+func ToFeatureOf[P any](f *Feature) (*FeatureOf[P], error) {
+	fo := &FeatureOf[P]{
+		ID:             f.ID,
+		Type:           f.Type,
+		BoundingBox:    f.BoundingBox,
+		Geometry:       f.Geometry,
+		CRS:            f.CRS,
+		NullProperties: f.NullProperties,
+		ForeignMembers: f.ForeignMembers,
+	}
+
+	if len(f.Properties) != 0 {
+		data, err := json.Marshal(f.Properties)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := decodeProperties(data, &fo.Properties); err != nil {
+			return nil, err
+		}
+	}
+
+	return fo, nil
+}
+
+// ToFeature converts the feature into an untyped feature.
+// The properties are converted using their JSON encoding.
+func (f *FeatureOf[P]) ToFeature() (*Feature, error) {
+	data, err := marshalProperties(f.Properties)
+	if err != nil {
+		return nil, err
+	}
+
+	properties := make(map[string]interface{})
+	if err := decodeProperties(data, &properties); err != nil {
+		return nil, err
+	}
+
+	return &Feature{
+		ID:             f.ID,
+		Type:           f.Type,
+		BoundingBox:    f.BoundingBox,
+		Geometry:       f.Geometry,
+		Properties:     properties,
+		CRS:            f.CRS,
+		NullProperties: f.NullProperties,
+		ForeignMembers: f.ForeignMembers,
+	}, nil
+}
+
+// GeoJSONType returns the type member of a feature, "Feature".
+func (f *FeatureOf[P]) GeoJSONType() string {
+	return "Feature"
+}
+
+// applyMarshalOptions returns a copy of the feature with the options applied,
+// keeping the typed properties, see Marshal.
+func (f *FeatureOf[P]) applyMarshalOptions(o *marshalOptions) Object {
+	return featureOfWithOptions(f, o)
+}
+
+func featureOfWithOptions[P any](f *FeatureOf[P], o *marshalOptions) *FeatureOf[P] {
+	if f == nil || !o.modifies() {
+		return f
+	}
+
+	c := *f
+	c.BoundingBox = o.boundingBox(f.BoundingBox)
+	c.Geometry = o.geometry(f.Geometry)
+
+	if o.fillBoundingBoxes {
+		c.BoundingBox = computeBoundingBox(c.Geometry)
+	}
+
+	return &c
+}
+
+// MarshalJSON converts the feature object into the proper JSON.
+// Properties that encode to null are written as an empty object unless NullProperties is set.
+func (f FeatureOf[P]) MarshalJSON() ([]byte, error) {
+	properties, err := marshalProperties(f.Properties)
+	if err != nil {
+		return nil, err
+	}
+
+	if f.NullProperties && bytes.Equal(properties, []byte("{}")) {
+		properties = json.RawMessage("null")
+	}
+
+	fea := &struct {
+		ID          interface{}            `json:"id,omitempty"`
+		Type        string                 `json:"type"`
+		BoundingBox []float64              `json:"bbox,omitempty"`
+		Geometry    *Geometry              `json:"geometry"`
+		Properties  json.RawMessage        `json:"properties"`
+		CRS         map[string]interface{} `json:"crs,omitempty"`
+	}{
+		ID:          f.ID,
+		Type:        "Feature",
+		BoundingBox: f.BoundingBox,
+		Geometry:    f.Geometry,
+		Properties:  properties,
+		CRS:         f.CRS,
+	}
+
+	data, err := json.Marshal(fea)
+	if err != nil {
+		return nil, err
+	}
+
+	return appendForeignMembers(data, f.ForeignMembers, featureMembers)
+}
+
+// UnmarshalFeatureOf decodes the data into a GeoJSON feature with properties of type P.
+// Alternately one can call json.Unmarshal(f) directly for the same result
+// when no options are needed.
+func UnmarshalFeatureOf[P any](data []byte, opts ...UnmarshalOption) (*FeatureOf[P], error) {
+	f := &FeatureOf[P]{}
+	err := decodeFeatureOf(f, data, newUnmarshalOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+
+	return f, nil
+}
+
+// UnmarshalJSON decodes the data into a GeoJSON feature.
+// This fulfills the json.Unmarshaler interface.
+func (f *FeatureOf[P]) UnmarshalJSON(data []byte) error {
+	return decodeFeatureOf(f, data, &unmarshalOptions{})
+}
+
+func decodeFeatureOf[P any](f *FeatureOf[P], data []byte, o *unmarshalOptions) error {
+	object, err := decodeFeatureObject(data)
+	if err != nil || object == nil {
+		return err
+	}
+
+	if raw, ok := object["properties"]; ok {
+		var zero P
+		f.Properties = zero
+		f.NullProperties = isNull(raw)
+		if err := decodeProperties(raw, &f.Properties); err != nil {
+			return err
+		}
+	}
+
+	members := &Feature{
+		ID:             f.ID,
+		Type:           f.Type,
+		BoundingBox:    f.BoundingBox,
+		Geometry:       f.Geometry,
+		CRS:            f.CRS,
+		ForeignMembers: f.ForeignMembers,
+	}
+	if err := decodeFeatureMembers(members, object, o); err != nil {
+		return err
+	}
+
+	f.ID = members.ID
+	f.Type = members.Type
+	f.BoundingBox = members.BoundingBox
+	f.Geometry = members.Geometry
+	f.CRS = members.CRS
+	f.ForeignMembers = members.ForeignMembers
+
+	return nil
+}
+
+// marshalProperties encodes typed properties, using an empty object for null.
+func marshalProperties(v interface{}) (json.RawMessage, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	if isNull(data) {
+		return json.RawMessage("{}"), nil
+	}
+
+	return data, nil
+}
+
+// decodeProperties decodes the properties member into v, reporting
+// mismatched values with their location in the properties.
+func decodeProperties(data []byte, v interface{}) error {
+	err := json.Unmarshal(data, v)
+
+	var ute *json.UnmarshalTypeError
+	if errors.As(err, &ute) {
+		path := "properties"
+		if ute.Field != "" {
+			path += "." + ute.Field
+		}
+
+		return &DecodeError{Path: path, Expected: ute.Type.String(), Actual: ute.Value}
+	}
+
+	return err
+}
+
+// A FeatureCollectionOf is a GeoJSON feature collection of features with properties of type P.
+type FeatureCollectionOf[P any] struct {
+	Type        string                 `json:"type"`
+	BoundingBox []float64              `json:"bbox,omitempty"`
+	Features    []*FeatureOf[P]        `json:"features"`
+	CRS         map[string]interface{} `json:"crs,omitempty"` // Coordinate Reference System Object, see ParseCRS
+
+	// ForeignMembers holds the members of the feature collection object not defined by the GeoJSON spec.
+	// They are written after the other members when marshaling.
+	ForeignMembers map[string]interface{} `json:"-"`
+}
+
+// NewFeatureCollectionOf creates and initializes a new feature collection with typed properties.
+func NewFeatureCollectionOf[P any]() *FeatureCollectionOf[P] {
+	return &FeatureCollectionOf[P]{
+		Type:     "FeatureCollection",
+		Features: make([]*FeatureOf[P], 0),
+	}
+}
+
+// AddFeature appends a feature to the collection.
+func (fc *FeatureCollectionOf[P]) AddFeature(feature *FeatureOf[P]) *FeatureCollectionOf[P] {
+	fc.Features = append(fc.Features, feature)
+	return fc
+}
+
+// ToFeatureCollectionOf converts the collection into one with features with properties of type P.
+func ToFeatureCollectionOf[P any](fc *FeatureCollection) (*FeatureCollectionOf[P], error) {
+	fco := &FeatureCollectionOf[P]{
+		Type:           fc.Type,
+		BoundingBox:    fc.BoundingBox,
+		Features:       make([]*FeatureOf[P], 0, len(fc.Features)),
+		CRS:            fc.CRS,
+		ForeignMembers: fc.ForeignMembers,
+	}
+
+	for i, f := range fc.Features {
+		var fo *FeatureOf[P]
+		if f != nil {
+			var err error
+			fo, err = ToFeatureOf[P](f)
+			if err != nil {
+				return nil, prefixPath(prefixIndex(err, i), "features")
+			}
+		}
+
+		fco.Features = append(fco.Features, fo)
+	}
+
+	return fco, nil
+}
+
+// ToFeatureCollection converts the collection into an untyped feature collection.
+func (fc *FeatureCollectionOf[P]) ToFeatureCollection() (*FeatureCollection, error) {
+	c := &FeatureCollection{
+		Type:           fc.Type,
+		BoundingBox:    fc.BoundingBox,
+		Features:       make([]*Feature, 0, len(fc.Features)),
+		CRS:            fc.CRS,
+		ForeignMembers: fc.ForeignMembers,
+	}
+
+	for i, fo := range fc.Features {
+		var f *Feature
+		if fo != nil {
+			var err error
+			f, err = fo.ToFeature()
+			if err != nil {
+				return nil, prefixPath(prefixIndex(err, i), "features")
+			}
+		}
+
+		c.Features = append(c.Features, f)
+	}
+
+	return c, nil
+}
+
+// GeoJSONType returns the type member of a feature collection, "FeatureCollection".
+func (fc *FeatureCollectionOf[P]) GeoJSONType() string {
+	return "FeatureCollection"
+}
+
+// applyMarshalOptions returns a copy of the collection with the options applied,
+// keeping the typed properties, see Marshal.
+func (fc *FeatureCollectionOf[P]) applyMarshalOptions(o *marshalOptions) Object {
+	if fc == nil || !o.modifies() {
+		return fc
+	}
+
+	c := *fc
+	c.BoundingBox = o.boundingBox(fc.BoundingBox)
+	c.Features = make([]*FeatureOf[P], len(fc.Features))
+	geometries := make([]*Geometry, 0, len(fc.Features))
+	for i, f := range fc.Features {
+		c.Features[i] = featureOfWithOptions(f, o)
+		if f != nil {
+			geometries = append(geometries, c.Features[i].Geometry)
+		}
+	}
+
+	if o.fillBoundingBoxes {
+		c.BoundingBox = computeBoundingBox(geometries...)
+	}
+
+	return &c
+}
+
+// MarshalJSON converts the feature collection object into the proper JSON.
+func (fc FeatureCollectionOf[P]) MarshalJSON() ([]byte, error) {
+	type featureCollection FeatureCollectionOf[P]
+
+	fcol := &featureCollection{
+		Type:        "FeatureCollection",
+		BoundingBox: fc.BoundingBox,
+		Features:    fc.Features,
+		CRS:         fc.CRS,
+	}
+
+	if fcol.Features == nil {
+		fcol.Features = make([]*FeatureOf[P], 0) // GeoJSON requires the feature attribute to be at least []
+	}
+
+	data, err := json.Marshal(fcol)
+	if err != nil {
+		return nil, err
+	}
+
+	return appendForeignMembers(data, fc.ForeignMembers, featureCollectionMembers)
+}
+
+// UnmarshalFeatureCollectionOf decodes the data into a GeoJSON feature collection
+// of features with properties of type P.
+// Alternately one can call json.Unmarshal(fc) directly for the same result
+// when no options are needed.
+func UnmarshalFeatureCollectionOf[P any](data []byte, opts ...UnmarshalOption) (*FeatureCollectionOf[P], error) {
+	fc := &FeatureCollectionOf[P]{}
+	err := decodeFeatureCollectionOf(fc, data, newUnmarshalOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+
+	return fc, nil
+}
+
+// UnmarshalJSON decodes the data into a GeoJSON feature collection.
+// This fulfills the json.Unmarshaler interface.
+func (fc *FeatureCollectionOf[P]) UnmarshalJSON(data []byte) error {
+	return decodeFeatureCollectionOf(fc, data, &unmarshalOptions{})
+}
+
+// decodeFeatureCollectionOf reads the collection with the streaming decoder,
+// decoding the properties of each feature directly into P.
+func decodeFeatureCollectionOf[P any](fc *FeatureCollectionOf[P], data []byte, o *unmarshalOptions) error {
+	if isNull(data) {
+		return nil
+	}
+
+	d := &FeatureCollectionDecoder{
+		dec:  json.NewDecoder(bytes.NewReader(data)),
+		opts: o,
+	}
+
+	fc.Features = make([]*FeatureOf[P], 0)
+	for {
+		raw, err := d.nextMember()
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if raw == nil {
+			fc.Features = append(fc.Features, nil)
+			continue
+		}
+
+		f := &FeatureOf[P]{}
+		if err := decodeFeatureOf(f, raw, o); err != nil {
+			return d.memberError(err)
+		}
+		d.trackDimension(f.Geometry)
+
+		fc.Features = append(fc.Features, f)
+	}
+
+	if _, err := d.dec.Token(); err != io.EOF {
+		return errors.New("invalid data after feature collection object")
+	}
+
+	fc.Type = d.typ
+	fc.BoundingBox = d.boundingBox
+	fc.CRS = d.crs
+	fc.ForeignMembers = d.foreignMembers
+
+	return nil
+}
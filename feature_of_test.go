@@ -0,0 +1,254 @@
+package geojson
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+type testProperties struct {
+	Name  string `json:"name"`
+	Count int    `json:"count,omitempty"`
+}
+
+func TestFeatureOfMarshalJSON(t *testing.T) {
+	f := NewFeatureOf(NewPointGeometry([]float64{1, 2}), testProperties{Name: "a"})
+	f.Type = "something"
+
+	data, err := json.Marshal(f)
+	if err != nil {
+		t.Fatalf("should marshal to json just fine but got %v", err)
+	}
+
+	expected := `{"type":"Feature","geometry":{"type":"Point","coordinates":[1,2]},"properties":{"name":"a"}}`
+	if string(data) != expected {
+		t.Errorf("incorrect json, got %s", data)
+	}
+
+	// nil properties should still be an object
+	p := NewFeatureOf[*testProperties](nil, nil)
+	data, err = json.Marshal(p)
+	if err != nil {
+		t.Fatalf("should marshal to json just fine but got %v", err)
+	}
+
+	if string(data) != `{"type":"Feature","geometry":null,"properties":{}}` {
+		t.Errorf("incorrect json, got %s", data)
+	}
+}
+
+func TestUnmarshalFeatureOf(t *testing.T) {
+	rawJSON := `{"type": "Feature", "id": 7, "geometry": {"type": "Point", "coordinates": [1, 2]},
+		"properties": {"name": "a", "count": 3, "other": true}, "title": "foreign"}`
+
+	f, err := UnmarshalFeatureOf[testProperties]([]byte(rawJSON))
+	if err != nil {
+		t.Fatalf("should unmarshal feature without issue, err %v", err)
+	}
+
+	if f.Properties.Name != "a" || f.Properties.Count != 3 {
+		t.Errorf("incorrect properties, got %v", f.Properties)
+	}
+
+	if f.ID != 7.0 {
+		t.Errorf("incorrect id, got %v", f.ID)
+	}
+
+	if f.Geometry == nil || !f.Geometry.IsPoint() {
+		t.Errorf("should have point geometry, got %v", f.Geometry)
+	}
+
+	if f.ForeignMembers["title"] != "foreign" {
+		t.Errorf("should have foreign member, got %v", f.ForeignMembers)
+	}
+
+	_, err = UnmarshalFeatureOf[testProperties]([]byte(`{"type": "Feature", "properties": {"count": "3"}}`))
+
+	var de *DecodeError
+	if !errors.As(err, &de) {
+		t.Fatalf("should return decode error, got %v", err)
+	}
+
+	if de.Path != "properties.count" || de.Expected != "int" || de.Actual != "string" {
+		t.Errorf("incorrect error, got %v", err)
+	}
+}
+
+func TestUnmarshalFeatureOfReset(t *testing.T) {
+	f := NewFeatureOf(nil, testProperties{Name: "a", Count: 3})
+
+	err := json.Unmarshal([]byte(`{"type": "Feature", "geometry": null, "properties": {"name": "b"}}`), f)
+	if err != nil {
+		t.Fatalf("should unmarshal feature without issue, err %v", err)
+	}
+
+	if f.Properties != (testProperties{Name: "b"}) {
+		t.Errorf("should not keep previous properties, got %v", f.Properties)
+	}
+}
+
+func TestMarshalFeatureOf(t *testing.T) {
+	f := NewFeatureOf(NewPointGeometry([]float64{1.123, 2.456}), testProperties{Name: "a"})
+
+	data, err := Marshal(f, Precision(1), FillBoundingBoxes())
+	if err != nil {
+		t.Fatalf("should marshal to json just fine but got %v", err)
+	}
+
+	expected := `{"type":"Feature","bbox":[1.1,2.5,1.1,2.5],"geometry":{"type":"Point","bbox":[1.1,2.5,1.1,2.5],"coordinates":[1.1,2.5]},"properties":{"name":"a"}}`
+	if string(data) != expected {
+		t.Errorf("incorrect json, got %s", data)
+	}
+
+	if f.Geometry.Point[0] != 1.123 || f.BoundingBox != nil {
+		t.Errorf("should not modify the original feature")
+	}
+
+	fc := NewFeatureCollectionOf[testProperties]().AddFeature(f).AddFeature(nil)
+	data, err = Marshal(fc, Precision(0), FillBoundingBoxes())
+	if err != nil {
+		t.Fatalf("should marshal to json just fine but got %v", err)
+	}
+
+	expected = `{"type":"FeatureCollection","bbox":[1,2,1,2],"features":[{"type":"Feature","bbox":[1,2,1,2],"geometry":{"type":"Point","bbox":[1,2,1,2],"coordinates":[1,2]},"properties":{"name":"a"}},null]}`
+	if string(data) != expected {
+		t.Errorf("incorrect json, got %s", data)
+	}
+
+	if data, err := Marshal((*FeatureOf[testProperties])(nil)); err != nil || string(data) != "null" {
+		t.Errorf("should marshal nil feature as null, got %s %v", data, err)
+	}
+
+	c, err := AsFeatureCollection(fc)
+	if err != nil {
+		t.Fatalf("should convert without issue, err %v", err)
+	}
+
+	if len(c.Features) != 2 || c.Features[0].PropertyMustString("name") != "a" {
+		t.Errorf("incorrect collection, got %v", c.Features)
+	}
+
+	c, err = AsFeatureCollection(f)
+	if err != nil {
+		t.Fatalf("should convert without issue, err %v", err)
+	}
+
+	if len(c.Features) != 1 || c.Features[0].PropertyMustString("name") != "a" {
+		t.Errorf("incorrect collection, got %v", c.Features)
+	}
+}
+
+func TestFeatureOfConversion(t *testing.T) {
+	f := NewPointFeature([]float64{1, 2})
+	f.ID = "id"
+	f.SetProperty("name", "a")
+	f.SetProperty("count", 3)
+
+	fo, err := ToFeatureOf[testProperties](f)
+	if err != nil {
+		t.Fatalf("should convert feature, got %v", err)
+	}
+
+	if fo.ID != "id" || fo.Properties.Name != "a" || fo.Properties.Count != 3 {
+		t.Errorf("incorrect feature, got %v", fo)
+	}
+
+	back, err := fo.ToFeature()
+	if err != nil {
+		t.Fatalf("should convert feature, got %v", err)
+	}
+
+	if back.PropertyMustString("name") != "a" || back.PropertyMustFloat64("count") != 3 {
+		t.Errorf("incorrect properties, got %v", back.Properties)
+	}
+}
+
+func TestFeatureCollectionOf(t *testing.T) {
+	rawJSON := `{"type": "FeatureCollection", "features": [
+		{"type": "Feature", "geometry": null, "properties": {"name": "a"}},
+		null,
+		{"type": "Feature", "geometry": null, "properties": {"name": "c"}}
+	], "title": "foreign"}`
+
+	fc, err := UnmarshalFeatureCollectionOf[testProperties]([]byte(rawJSON))
+	if err != nil {
+		t.Fatalf("should unmarshal feature collection without issue, err %v", err)
+	}
+
+	if len(fc.Features) != 3 {
+		t.Fatalf("should have 3 features, got %d", len(fc.Features))
+	}
+
+	if fc.Features[0].Properties.Name != "a" || fc.Features[1] != nil || fc.Features[2].Properties.Name != "c" {
+		t.Errorf("incorrect features, got %v", fc.Features)
+	}
+
+	data, err := json.Marshal(fc)
+	if err != nil {
+		t.Fatalf("should marshal to json just fine but got %v", err)
+	}
+
+	expected := `{"type":"FeatureCollection","features":[` +
+		`{"type":"Feature","geometry":null,"properties":{"name":"a"}},` +
+		`null,` +
+		`{"type":"Feature","geometry":null,"properties":{"name":"c"}}` +
+		`],"title":"foreign"}`
+	if string(data) != expected {
+		t.Errorf("incorrect json, got %s", data)
+	}
+
+	untyped, err := fc.ToFeatureCollection()
+	if err != nil {
+		t.Fatalf("should convert feature collection, got %v", err)
+	}
+
+	back, err := ToFeatureCollectionOf[testProperties](untyped)
+	if err != nil {
+		t.Fatalf("should convert feature collection, got %v", err)
+	}
+
+	if len(back.Features) != 3 || back.Features[2].Properties.Name != "c" {
+		t.Errorf("incorrect features, got %v", back.Features)
+	}
+
+	_, err = UnmarshalFeatureCollectionOf[testProperties]([]byte(`{"type": "FeatureCollection", "features": [
+		{"type": "Feature", "geometry": null, "properties": {"name": 1}}
+	]}`))
+
+	var de *DecodeError
+	if !errors.As(err, &de) || de.Path != "features[0].properties.name" {
+		t.Errorf("should return decode error with path, got %v", err)
+	}
+}
+
+func TestFeatureOfNullProperties(t *testing.T) {
+	rawJSON := `{"type":"Feature","geometry":null,"properties":null}`
+
+	f, err := UnmarshalFeatureOf[*testProperties]([]byte(rawJSON))
+	if err != nil {
+		t.Fatalf("should unmarshal feature without issue, err %v", err)
+	}
+
+	if !f.NullProperties || f.Properties != nil {
+		t.Errorf("should decode null properties, got %v", f)
+	}
+
+	data, err := json.Marshal(f)
+	if err != nil {
+		t.Fatalf("should marshal to json just fine but got %v", err)
+	}
+
+	if string(data) != rawJSON {
+		t.Errorf("should round trip exactly, got %s", data)
+	}
+
+	untyped, err := f.ToFeature()
+	if err != nil {
+		t.Fatalf("should convert feature, got %v", err)
+	}
+
+	data, _ = json.Marshal(untyped)
+	if string(data) != rawJSON {
+		t.Errorf("should keep null properties when converting, got %s", data)
+	}
+}
@@ -138,3 +138,106 @@ func TestUnmarshalFeatureID(t *testing.T) {
 		t.Errorf("should parse id as string, got %T %s", f.ID, v)
 	}
 }
+
+func TestFeatureIDAccessors(t *testing.T) {
+	rawJSON := `{"type": "Feature", "id": 9007199254740993, "geometry": null}`
+
+	f, err := UnmarshalFeature([]byte(rawJSON), UseNumber())
+	if err != nil {
+		t.Fatalf("should unmarshal feature without issue, err %v", err)
+	}
+
+	if id, err := f.IDInt64(); err != nil || id != 9007199254740993 {
+		t.Errorf("should return exact id, got %v %v", id, err)
+	}
+
+	if _, err := f.IDString(); err == nil {
+		t.Errorf("should return error for numeric id")
+	}
+
+	data, _ := f.MarshalJSON()
+	if !bytes.Contains(data, []byte(`"id":9007199254740993`)) {
+		t.Errorf("should marshal exact id, got %v", string(data))
+	}
+
+	f.ID = "123"
+	if id, err := f.IDString(); err != nil || id != "123" {
+		t.Errorf("should return string id, got %v %v", id, err)
+	}
+
+	if _, err := f.IDInt64(); err == nil {
+		t.Errorf("should return error for string id")
+	}
+
+	if _, err := f.IDFloat64(); err == nil {
+		t.Errorf("should return error for string id")
+	}
+
+	f.ID = 1.5
+	if _, err := f.IDInt64(); err == nil {
+		t.Errorf("should return error for non integer id")
+	}
+
+	if id, err := f.IDFloat64(); err != nil || id != 1.5 {
+		t.Errorf("should return float id, got %v %v", id, err)
+	}
+}
+
+func TestFeatureNullRoundTrip(t *testing.T) {
+	cases := []struct {
+		name string
+		data string
+	}{
+		{
+			name: "null properties",
+			data: `{"type":"Feature","geometry":null,"properties":null}`,
+		},
+		{
+			name: "empty properties",
+			data: `{"type":"Feature","geometry":null,"properties":{}}`,
+		},
+		{
+			name: "point with null properties",
+			data: `{"type":"Feature","geometry":{"type":"Point","coordinates":[1,2]},"properties":null}`,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			f, err := UnmarshalFeature([]byte(tc.data))
+			if err != nil {
+				t.Fatalf("should unmarshal feature without issue, err %v", err)
+			}
+
+			data, err := json.Marshal(f)
+			if err != nil {
+				t.Fatalf("should marshal to json just fine but got %v", err)
+			}
+
+			if string(data) != tc.data {
+				t.Errorf("should round trip exactly, got %s", data)
+			}
+		})
+	}
+
+	f, _ := UnmarshalFeature([]byte(`{"type":"Feature","geometry":null,"properties":null}`))
+	if !f.NullProperties || f.Properties != nil || f.Geometry != nil {
+		t.Errorf("should decode null members, got %v", f)
+	}
+
+	// setting a property overrides the flag
+	f.SetProperty("a", 1)
+	data, _ := json.Marshal(f)
+	if string(data) != `{"type":"Feature","geometry":null,"properties":{"a":1}}` {
+		t.Errorf("incorrect json, got %s", data)
+	}
+
+	// decoding into an existing feature resets the flag
+	if err := json.Unmarshal([]byte(`{"type":"Feature","geometry":null,"properties":{}}`), f); err != nil {
+		t.Fatalf("should unmarshal feature without issue, err %v", err)
+	}
+
+	if f.NullProperties {
+		t.Errorf("should reset null properties")
+	}
+}
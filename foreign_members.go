@@ -0,0 +1,57 @@
+package geojson
+
+import (
+	"encoding/json"
+	"sort"
+)
+
+// The members defined by the GeoJSON spec for each object type. Any other
+// members are foreign members, see RFC 7946 section 6.1.
+var (
+	geometryMembers = map[string]bool{
+		"type": true, "bbox": true, "coordinates": true, "geometries": true, "crs": true,
+	}
+	featureMembers = map[string]bool{
+		"id": true, "type": true, "bbox": true, "geometry": true, "properties": true, "crs": true,
+	}
+	featureCollectionMembers = map[string]bool{
+		"type": true, "bbox": true, "features": true, "crs": true,
+	}
+)
+
+// appendForeignMembers adds the foreign members, in key order, to the end of the encoded object.
+// Members with the same name as one defined by the spec for the object are skipped.
+func appendForeignMembers(data []byte, members map[string]interface{}, defined map[string]bool) ([]byte, error) {
+	if len(members) == 0 {
+		return data, nil
+	}
+
+	keys := make([]string, 0, len(members))
+	for k := range members {
+		if !defined[k] {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	// remove the closing brace of the object
+	data = data[:len(data)-1]
+	for _, k := range keys {
+		key, err := json.Marshal(k)
+		if err != nil {
+			return nil, err
+		}
+
+		value, err := json.Marshal(members[k])
+		if err != nil {
+			return nil, err
+		}
+
+		data = append(data, ',')
+		data = append(data, key...)
+		data = append(data, ':')
+		data = append(data, value...)
+	}
+
+	return append(data, '}'), nil
+}
@@ -0,0 +1,92 @@
+package geojson
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestGeometryForeignMembers(t *testing.T) {
+	rawJSON := `{"type":"Point","coordinates":[1,2],"title":"home","links":[{"href":"http://example.com"}]}`
+
+	g, err := UnmarshalGeometry([]byte(rawJSON))
+	if err != nil {
+		t.Fatalf("should unmarshal geometry without issue, err %v", err)
+	}
+
+	if g.ForeignMembers["title"] != "home" {
+		t.Errorf("should keep foreign members, got %v", g.ForeignMembers)
+	}
+
+	data, err := g.MarshalJSON()
+	if err != nil {
+		t.Fatalf("should marshal to json just fine but got %v", err)
+	}
+
+	expected := `{"type":"Point","coordinates":[1,2],"links":[{"href":"http://example.com"}],"title":"home"}`
+	if string(data) != expected {
+		t.Errorf("should write foreign members, got %v", string(data))
+	}
+}
+
+func TestFeatureForeignMembers(t *testing.T) {
+	rawJSON := `{"type":"Feature","geometry":null,"properties":{},"timeStamp":"2017-01-01"}`
+
+	f, err := UnmarshalFeature([]byte(rawJSON))
+	if err != nil {
+		t.Fatalf("should unmarshal feature without issue, err %v", err)
+	}
+
+	if len(f.ForeignMembers) != 1 || f.ForeignMembers["timeStamp"] != "2017-01-01" {
+		t.Errorf("should keep foreign members, got %v", f.ForeignMembers)
+	}
+
+	data, err := f.MarshalJSON()
+	if err != nil {
+		t.Fatalf("should marshal to json just fine but got %v", err)
+	}
+
+	if string(data) != rawJSON {
+		t.Errorf("should write foreign members, got %v", string(data))
+	}
+
+	// spec members can not be overwritten
+	f.ForeignMembers["type"] = "Other"
+	data, _ = f.MarshalJSON()
+	if bytes.Contains(data, []byte("Other")) {
+		t.Errorf("should not write foreign members with spec names, got %v", string(data))
+	}
+}
+
+func TestFeatureCollectionForeignMembers(t *testing.T) {
+	rawJSON := `{"type":"FeatureCollection","features":[{"type":"Feature","geometry":null,"properties":{},"a":1}],"title":"parcels"}`
+
+	fc, err := UnmarshalFeatureCollection([]byte(rawJSON))
+	if err != nil {
+		t.Fatalf("should unmarshal feature collection without issue, err %v", err)
+	}
+
+	if fc.ForeignMembers["title"] != "parcels" {
+		t.Errorf("should keep foreign members, got %v", fc.ForeignMembers)
+	}
+
+	data, err := fc.MarshalJSON()
+	if err != nil {
+		t.Fatalf("should marshal to json just fine but got %v", err)
+	}
+
+	if string(data) != rawJSON {
+		t.Errorf("should write foreign members, got %v", string(data))
+	}
+
+	buf := &bytes.Buffer{}
+	e := NewFeatureCollectionEncoder(buf)
+	e.ForeignMembers = fc.ForeignMembers
+	e.AddFeature(fc.Features[0])
+	if err := e.Close(); err != nil {
+		t.Fatalf("should close without issue, err %v", err)
+	}
+
+	if buf.String() != rawJSON {
+		t.Errorf("encoder should write foreign members, got %v", buf.String())
+	}
+}
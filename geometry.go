@@ -1,10 +1,11 @@
 package geojson
 
 import (
+	"bytes"
 	"database/sql/driver"
 	"encoding/json"
 	"errors"
-	"fmt"
+	"io"
 )
 
 // A GeometryType serves to enumerate the different GeoJSON geometry types.
@@ -32,7 +33,11 @@ type Geometry struct {
 	Polygon         [][][]float64
 	MultiPolygon    [][][][]float64
 	Geometries      []*Geometry
-	CRS             map[string]interface{} `json:"crs,omitempty"` // Coordinate Reference System Objects are not currently supported
+	CRS             map[string]interface{} `json:"crs,omitempty"` // Coordinate Reference System Object, see ParseCRS
+
+	// ForeignMembers holds the members of the geometry object not defined by the GeoJSON spec.
+	// They are written after the other members when marshaling.
+	ForeignMembers map[string]interface{} `json:"-"`
 }
 
 // NewPointGeometry creates and initializes a point geometry with the give coordinate.
@@ -91,6 +96,31 @@ func NewCollectionGeometry(geometries ...*Geometry) *Geometry {
 	}
 }
 
+// NewEmptyGeometry creates a geometry of the given type without any positions.
+// It is marshaled with an empty coordinates, or geometries, array.
+func NewEmptyGeometry(t GeometryType) *Geometry {
+	g := &Geometry{Type: t}
+
+	switch t {
+	case GeometryPoint:
+		g.Point = []float64{}
+	case GeometryMultiPoint:
+		g.MultiPoint = [][]float64{}
+	case GeometryLineString:
+		g.LineString = [][]float64{}
+	case GeometryMultiLineString:
+		g.MultiLineString = [][][]float64{}
+	case GeometryPolygon:
+		g.Polygon = [][][]float64{}
+	case GeometryMultiPolygon:
+		g.MultiPolygon = [][][][]float64{}
+	case GeometryCollection:
+		g.Geometries = []*Geometry{}
+	}
+
+	return g
+}
+
 // MarshalJSON converts the geometry object into the correct JSON.
 // This fulfills the json.Marshaler interface.
 func (g Geometry) MarshalJSON() ([]byte, error) {
@@ -111,48 +141,82 @@ func (g Geometry) MarshalJSON() ([]byte, error) {
 		geo.BoundingBox = g.BoundingBox
 	}
 
+	// the coordinates or geometries member is required, so empty geometries are written as []
 	switch g.Type {
 	case GeometryPoint:
 		geo.Coordinates = g.Point
+		if g.Point == nil {
+			geo.Coordinates = []float64{}
+		}
 	case GeometryMultiPoint:
 		geo.Coordinates = g.MultiPoint
+		if g.MultiPoint == nil {
+			geo.Coordinates = [][]float64{}
+		}
 	case GeometryLineString:
 		geo.Coordinates = g.LineString
+		if g.LineString == nil {
+			geo.Coordinates = [][]float64{}
+		}
 	case GeometryMultiLineString:
 		geo.Coordinates = g.MultiLineString
+		if g.MultiLineString == nil {
+			geo.Coordinates = [][][]float64{}
+		}
 	case GeometryPolygon:
 		geo.Coordinates = g.Polygon
+		if g.Polygon == nil {
+			geo.Coordinates = [][][]float64{}
+		}
 	case GeometryMultiPolygon:
 		geo.Coordinates = g.MultiPolygon
+		if g.MultiPolygon == nil {
+			geo.Coordinates = [][][][]float64{}
+		}
 	case GeometryCollection:
 		geo.Geometries = g.Geometries
+		if g.Geometries == nil {
+			geo.Geometries = []*Geometry{}
+		}
+	}
+
+	if g.CRS != nil && len(g.CRS) != 0 {
+		geo.CRS = g.CRS
 	}
 
-	return json.Marshal(geo)
+	data, err := json.Marshal(geo)
+	if err != nil {
+		return nil, err
+	}
+
+	return appendForeignMembers(data, g.ForeignMembers, geometryMembers)
 }
 
 // UnmarshalGeometry decodes the data into a GeoJSON geometry.
-// Alternately one can call json.Unmarshal(g) directly for the same result.
-func UnmarshalGeometry(data []byte) (*Geometry, error) {
+// Alternately one can call json.Unmarshal(g) directly for the same result
+// when no options are needed.
+func UnmarshalGeometry(data []byte, opts ...UnmarshalOption) (*Geometry, error) {
+	o := newUnmarshalOptions(opts)
+
 	g := &Geometry{}
 	err := json.Unmarshal(data, g)
 	if err != nil {
 		return nil, err
 	}
 
+	if o.strict {
+		if err := checkStrictGeometry(g, o); err != nil {
+			return nil, err
+		}
+	}
+
 	return g, nil
 }
 
 // UnmarshalJSON decodes the data into a GeoJSON geometry.
 // This fulfills the json.Unmarshaler interface.
 func (g *Geometry) UnmarshalJSON(data []byte) error {
-	var object map[string]interface{}
-	err := json.Unmarshal(data, &object)
-	if err != nil {
-		return err
-	}
-
-	return decodeGeometry(g, object)
+	return decodeGeometry(g, data)
 }
 
 // Scan implements the sql.Scanner interface allowing
@@ -181,56 +245,264 @@ func (g *Geometry) Value() (driver.Value, error) {
 	return g.MarshalJSON()
 }
 
-func decodeGeometry(g *Geometry, object map[string]interface{}) error {
-	t, ok := object["type"]
-	if !ok {
-		return errors.New("type property not defined")
+// decodeGeometry reads the members of the geometry object in a single pass.
+// If the type member has already been read the coordinates are decoded directly
+// into the typed field, otherwise they are kept and decoded once the type is known.
+func decodeGeometry(g *Geometry, data []byte) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+
+	t, err := dec.Token()
+	if err != nil {
+		return err
 	}
 
-	if s, ok := t.(string); ok {
-		g.Type = GeometryType(s)
-	} else {
-		return errors.New("type property not string")
+	if t != json.Delim('{') {
+		return &DecodeError{Expected: "geometry object", Actual: jsonType(t)}
 	}
 
-	bb, err := decodeBoundingBox(object["bbox"])
-	if err != nil {
+	var (
+		hasType, hasCoordinates bool
+		coordinates, geometries json.RawMessage
+	)
+
+	for dec.More() {
+		key, err := dec.Token()
+		if err != nil {
+			return err
+		}
+
+		switch key {
+		case "type":
+			var t interface{}
+			if err := dec.Decode(&t); err != nil {
+				return err
+			}
+
+			s, ok := t.(string)
+			if !ok {
+				return &DecodeError{Path: "type", Expected: "string", Actual: jsonType(t)}
+			}
+			g.Type = GeometryType(s)
+			hasType = true
+		case "bbox":
+			var bb interface{}
+			if err := dec.Decode(&bb); err != nil {
+				return err
+			}
+
+			g.BoundingBox, err = decodeBoundingBox(bb)
+			if err != nil {
+				return prefixPath(err, "bbox")
+			}
+		case "coordinates":
+			hasCoordinates = true
+			if !hasType {
+				if err := dec.Decode(&coordinates); err != nil {
+					return err
+				}
+				continue
+			}
+
+			start := dec.InputOffset()
+			err := decodeCoordinates(g, dec.Decode)
+			if err == nil {
+				err = checkNullCoordinates(g, data[start:dec.InputOffset()])
+			}
+
+			if err != nil {
+				if !isCoordinatesError(err) {
+					return err
+				}
+
+				err = decodeCoordinatesSlow(g, data[start:dec.InputOffset()])
+				return prefixPath(err, "coordinates")
+			}
+		case "geometries":
+			if err := dec.Decode(&geometries); err != nil {
+				return err
+			}
+		case "crs":
+			var crs interface{}
+			if err := dec.Decode(&crs); err != nil {
+				return err
+			}
+
+			m, ok := crs.(map[string]interface{})
+			if !ok && crs != nil {
+				return &DecodeError{Path: "crs", Expected: "object", Actual: jsonType(crs)}
+			}
+			g.CRS = m
+		default:
+			var v interface{}
+			if err := dec.Decode(&v); err != nil {
+				return err
+			}
+
+			if g.ForeignMembers == nil {
+				g.ForeignMembers = make(map[string]interface{})
+			}
+			g.ForeignMembers[key.(string)] = v
+		}
+	}
+
+	// the closing brace of the object, anything after it is invalid
+	if _, err := dec.Token(); err != nil {
+		return err
+	}
+
+	if _, err := dec.Token(); err != io.EOF {
+		return errors.New("invalid data after geometry object")
+	}
+
+	if !hasType {
+		return &DecodeError{Path: "type", Expected: "string", Actual: "missing"}
+	}
+
+	switch g.Type {
+	case GeometryPoint, GeometryMultiPoint, GeometryLineString, GeometryMultiLineString,
+		GeometryPolygon, GeometryMultiPolygon:
+		if !hasCoordinates {
+			return prefixPath(decodeCoordinatesSlow(g, nil), "coordinates")
+		}
+
+		if coordinates != nil {
+			err := decodeCoordinates(g, func(v interface{}) error {
+				return json.Unmarshal(coordinates, v)
+			})
+			if err == nil {
+				err = checkNullCoordinates(g, coordinates)
+			}
+
+			if err != nil {
+				if !isCoordinatesError(err) {
+					return err
+				}
+
+				return prefixPath(decodeCoordinatesSlow(g, coordinates), "coordinates")
+			}
+		}
+	case GeometryCollection:
+		g.Geometries, err = decodeGeometries(geometries)
+		if err != nil {
+			return prefixPath(err, "geometries")
+		}
+	}
+
+	return nil
+}
+
+// decodeCoordinates decodes the coordinates directly into the field for the geometry type.
+// An error is returned if the data does not match the expected structure,
+// decodeCoordinatesSlow can then be used to determine the exact problem.
+// The result must be checked with checkNullCoordinates.
+func decodeCoordinates(g *Geometry, unmarshal func(v interface{}) error) error {
+	switch g.Type {
+	case GeometryPoint:
+		g.Point = nil
+		return unmarshal(&g.Point)
+	case GeometryMultiPoint:
+		g.MultiPoint = nil
+		return unmarshal(&g.MultiPoint)
+	case GeometryLineString:
+		g.LineString = nil
+		return unmarshal(&g.LineString)
+	case GeometryMultiLineString:
+		g.MultiLineString = nil
+		return unmarshal(&g.MultiLineString)
+	case GeometryPolygon:
+		g.Polygon = nil
+		return unmarshal(&g.Polygon)
+	case GeometryMultiPolygon:
+		g.MultiPolygon = nil
+		return unmarshal(&g.MultiPolygon)
+	}
+
+	// coordinates are not used by this type
+	var skip json.RawMessage
+	return unmarshal(&skip)
+}
+
+var errNullCoordinates = errors.New("null coordinates")
+
+// checkNullCoordinates returns errNullCoordinates if the raw coordinates of the geometry
+// contain a null. Decoding into the typed fields silently turns a null position into
+// a nil slice and a null number into zero, neither of which is valid.
+func checkNullCoordinates(g *Geometry, raw []byte) error {
+	if _, ok := expectedCoordinates[g.Type]; !ok {
+		return nil
+	}
+
+	if bytes.Contains(raw, []byte("null")) {
+		return errNullCoordinates
+	}
+
+	return nil
+}
+
+// isCoordinatesError returns true if the error from decodeCoordinates is caused by
+// the structure of valid JSON, as opposed to a syntax error in the data.
+func isCoordinatesError(err error) bool {
+	var typeErr *json.UnmarshalTypeError
+	return err == errNullCoordinates || errors.As(err, &typeErr)
+}
+
+// decodeCoordinatesSlow decodes the coordinates by walking the generic JSON structure
+// which allows for more descriptive errors when the data is not valid.
+func decodeCoordinatesSlow(g *Geometry, data []byte) error {
+	var (
+		object interface{}
+		err    error
+	)
+
+	data = bytes.TrimLeft(data, ": \t\r\n")
+	if len(data) == 0 {
+		return &DecodeError{Expected: expectedCoordinates[g.Type], Actual: "missing"}
+	}
+
+	if err := json.Unmarshal(data, &object); err != nil {
 		return err
 	}
-	g.BoundingBox = bb
 
 	switch g.Type {
 	case GeometryPoint:
-		g.Point, err = decodePosition(object["coordinates"])
+		g.Point, err = decodePosition(object)
 	case GeometryMultiPoint:
-		g.MultiPoint, err = decodePositionSet(object["coordinates"])
+		g.MultiPoint, err = decodePositionSet(object)
 	case GeometryLineString:
-		g.LineString, err = decodePositionSet(object["coordinates"])
+		g.LineString, err = decodePositionSet(object)
 	case GeometryMultiLineString:
-		g.MultiLineString, err = decodePathSet(object["coordinates"])
+		g.MultiLineString, err = decodePathSet(object)
 	case GeometryPolygon:
-		g.Polygon, err = decodePathSet(object["coordinates"])
+		g.Polygon, err = decodePathSet(object)
 	case GeometryMultiPolygon:
-		g.MultiPolygon, err = decodePolygonSet(object["coordinates"])
-	case GeometryCollection:
-		g.Geometries, err = decodeGeometries(object["geometries"])
+		g.MultiPolygon, err = decodePolygonSet(object)
 	}
 
 	return err
 }
 
+// expectedCoordinates describes the coordinates expected for each geometry type.
+var expectedCoordinates = map[GeometryType]string{
+	GeometryPoint:           "position",
+	GeometryMultiPoint:      "array of positions",
+	GeometryLineString:      "array of positions",
+	GeometryMultiLineString: "array of position arrays",
+	GeometryPolygon:         "array of position arrays",
+	GeometryMultiPolygon:    "array of polygons",
+}
+
 func decodePosition(data interface{}) ([]float64, error) {
 	coords, ok := data.([]interface{})
 	if !ok {
-		return nil, fmt.Errorf("not a valid position, got %v", data)
+		return nil, &DecodeError{Expected: "position", Actual: jsonType(data)}
 	}
 
 	result := make([]float64, 0, len(coords))
-	for _, coord := range coords {
+	for i, coord := range coords {
 		if f, ok := coord.(float64); ok {
 			result = append(result, f)
 		} else {
-			return nil, fmt.Errorf("not a valid coordinate, got %v", coord)
+			return nil, prefixIndex(&DecodeError{Expected: "number", Actual: jsonType(coord)}, i)
 		}
 	}
 
@@ -240,15 +512,15 @@ func decodePosition(data interface{}) ([]float64, error) {
 func decodePositionSet(data interface{}) ([][]float64, error) {
 	points, ok := data.([]interface{})
 	if !ok {
-		return nil, fmt.Errorf("not a valid set of positions, got %v", data)
+		return nil, &DecodeError{Expected: "array of positions", Actual: jsonType(data)}
 	}
 
 	result := make([][]float64, 0, len(points))
-	for _, point := range points {
+	for i, point := range points {
 		if p, err := decodePosition(point); err == nil {
 			result = append(result, p)
 		} else {
-			return nil, err
+			return nil, prefixIndex(err, i)
 		}
 	}
 
@@ -258,16 +530,16 @@ func decodePositionSet(data interface{}) ([][]float64, error) {
 func decodePathSet(data interface{}) ([][][]float64, error) {
 	sets, ok := data.([]interface{})
 	if !ok {
-		return nil, fmt.Errorf("not a valid path, got %v", data)
+		return nil, &DecodeError{Expected: "array of position arrays", Actual: jsonType(data)}
 	}
 
 	result := make([][][]float64, 0, len(sets))
 
-	for _, set := range sets {
+	for i, set := range sets {
 		if s, err := decodePositionSet(set); err == nil {
 			result = append(result, s)
 		} else {
-			return nil, err
+			return nil, prefixIndex(err, i)
 		}
 	}
 
@@ -277,46 +549,38 @@ func decodePathSet(data interface{}) ([][][]float64, error) {
 func decodePolygonSet(data interface{}) ([][][][]float64, error) {
 	polygons, ok := data.([]interface{})
 	if !ok {
-		return nil, fmt.Errorf("not a valid polygon, got %v", data)
+		return nil, &DecodeError{Expected: "array of polygons", Actual: jsonType(data)}
 	}
 
 	result := make([][][][]float64, 0, len(polygons))
-	for _, polygon := range polygons {
+	for i, polygon := range polygons {
 		if p, err := decodePathSet(polygon); err == nil {
 			result = append(result, p)
 		} else {
-			return nil, err
+			return nil, prefixIndex(err, i)
 		}
 	}
 
 	return result, nil
 }
 
-func decodeGeometries(data interface{}) ([]*Geometry, error) {
-	if vs, ok := data.([]interface{}); ok {
-		geometries := make([]*Geometry, 0, len(vs))
-		for _, v := range vs {
-			g := &Geometry{}
-
-			vmap, ok := v.(map[string]interface{})
-			if !ok {
-				break
-			}
-
-			err := decodeGeometry(g, vmap)
-			if err != nil {
-				return nil, err
-			}
+func decodeGeometries(data json.RawMessage) ([]*Geometry, error) {
+	var vs []json.RawMessage
+	if err := json.Unmarshal(data, &vs); err != nil || vs == nil {
+		return nil, &DecodeError{Expected: "array of geometries", Actual: rawType(data)}
+	}
 
-			geometries = append(geometries, g)
+	geometries := make([]*Geometry, 0, len(vs))
+	for i, v := range vs {
+		g := &Geometry{}
+		if err := decodeGeometry(g, v); err != nil {
+			return nil, prefixIndex(err, i)
 		}
 
-		if len(geometries) == len(vs) {
-			return geometries, nil
-		}
+		geometries = append(geometries, g)
 	}
 
-	return nil, fmt.Errorf("not a valid set of geometries, got %v", data)
+	return geometries, nil
 }
 
 // IsPoint returns true with the geometry object is a Point type.
@@ -353,3 +617,43 @@ func (g *Geometry) IsMultiPolygon() bool {
 func (g *Geometry) IsCollection() bool {
 	return g.Type == GeometryCollection
 }
+
+// IsEmpty returns true if the geometry does not have any positions, e.g. a point without
+// coordinates, a multi-line string whose lines are all empty or a polygon without an exterior ring.
+// A geometry collection is empty if all of its geometries are empty.
+func (g *Geometry) IsEmpty() bool {
+	switch g.Type {
+	case GeometryPoint:
+		return len(g.Point) == 0
+	case GeometryMultiPoint:
+		for _, p := range g.MultiPoint {
+			if len(p) != 0 {
+				return false
+			}
+		}
+	case GeometryLineString:
+		return len(g.LineString) == 0
+	case GeometryMultiLineString:
+		for _, ls := range g.MultiLineString {
+			if len(ls) != 0 {
+				return false
+			}
+		}
+	case GeometryPolygon:
+		return len(g.Polygon) == 0 || len(g.Polygon[0]) == 0
+	case GeometryMultiPolygon:
+		for _, p := range g.MultiPolygon {
+			if len(p) != 0 && len(p[0]) != 0 {
+				return false
+			}
+		}
+	case GeometryCollection:
+		for _, c := range g.Geometries {
+			if c != nil && !c.IsEmpty() {
+				return false
+			}
+		}
+	}
+
+	return true
+}
@@ -3,6 +3,7 @@ package geojson
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"testing"
 )
 
@@ -325,6 +326,21 @@ func TestGeometryScanFail(t *testing.T) {
 	if err == nil {
 		t.Errorf("should return error if not the correct data type")
 	}
+
+	for _, data := range []string{
+		`{"type":"Point","coordinates":[1,2`,
+		`{"type":"Point","coordinates":[1,x]}`,
+	} {
+		err := g.Scan(data)
+		if err == nil {
+			t.Errorf("should return error for invalid json %s", data)
+		}
+
+		var decodeErr *DecodeError
+		if errors.As(err, &decodeErr) {
+			t.Errorf("should return syntax error for %s, got %v", data, err)
+		}
+	}
 }
 
 func TestGeometryScan(t *testing.T) {
@@ -362,3 +378,190 @@ func TestGeometryScan(t *testing.T) {
 	}
 
 }
+
+func TestUnmarshalGeometryTypeAfterCoordinates(t *testing.T) {
+	rawJSON := `{"coordinates": [[[1,2],[3,4],[5,6],[1,2]]], "bbox": [1,2,5,6], "type": "Polygon"}`
+
+	g, err := UnmarshalGeometry([]byte(rawJSON))
+	if err != nil {
+		t.Fatalf("should unmarshal geometry without issue, err %v", err)
+	}
+
+	if g.Type != "Polygon" {
+		t.Errorf("incorrect type, got %v", g.Type)
+	}
+
+	if len(g.Polygon) != 1 || len(g.Polygon[0]) != 4 {
+		t.Errorf("should have decoded polygon coordinates, got %v", g.Polygon)
+	}
+}
+
+func TestUnmarshalGeometryErrors(t *testing.T) {
+	cases := []struct {
+		name string
+		data string
+		err  string
+	}{
+		{
+			name: "missing type",
+			data: `{"coordinates": [1, 2]}`,
+			err:  "type: expected string, got missing",
+		},
+		{
+			name: "type not string",
+			data: `{"type": 1, "coordinates": [1, 2]}`,
+			err:  "type: expected string, got number",
+		},
+		{
+			name: "missing coordinates",
+			data: `{"type": "Point"}`,
+			err:  "coordinates: expected position, got missing",
+		},
+		{
+			name: "invalid coordinate",
+			data: `{"type": "LineString", "coordinates": [[1, 2], [3, "foo"]]}`,
+			err:  "coordinates[1][1]: expected number, got string",
+		},
+		{
+			name: "invalid coordinate before type",
+			data: `{"coordinates": [[1, 2], [3, "foo"]], "type": "LineString"}`,
+			err:  "coordinates[1][1]: expected number, got string",
+		},
+		{
+			name: "null position",
+			data: `{"type": "MultiPolygon", "coordinates": [[[[1, 2], null]]]}`,
+			err:  "coordinates[0][0][1]: expected position, got null",
+		},
+		{
+			name: "null number",
+			data: `{"type": "Point", "coordinates": [1, null]}`,
+			err:  "coordinates[1]: expected number, got null",
+		},
+		{
+			name: "null number in line string",
+			data: `{"type": "LineString", "coordinates": [[1, 2], [3, null]]}`,
+			err:  "coordinates[1][1]: expected number, got null",
+		},
+		{
+			name: "null number before type",
+			data: `{"coordinates": [[1, 2], [null, 4]], "type": "LineString"}`,
+			err:  "coordinates[1][0]: expected number, got null",
+		},
+		{
+			name: "null coordinates",
+			data: `{"type": "Point", "coordinates": null}`,
+			err:  "coordinates: expected position, got null",
+		},
+		{
+			name: "invalid geometries",
+			data: `{"type": "GeometryCollection", "geometries": [1]}`,
+			err:  "geometries[0]: expected geometry object, got number",
+		},
+		{
+			name: "invalid child geometry",
+			data: `{"type": "GeometryCollection", "geometries": [{"type": "Point", "coordinates": null}]}`,
+			err:  "geometries[0].coordinates: expected position, got null",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := UnmarshalGeometry([]byte(tc.data))
+			if err == nil {
+				t.Fatalf("should return error")
+			}
+
+			if err.Error() != tc.err {
+				t.Errorf("incorrect error, got %v", err)
+			}
+		})
+	}
+}
+
+func TestGeometryMarshalJSONEmpty(t *testing.T) {
+	cases := []struct {
+		typ      GeometryType
+		expected string
+	}{
+		{GeometryPoint, `{"type":"Point","coordinates":[]}`},
+		{GeometryMultiPoint, `{"type":"MultiPoint","coordinates":[]}`},
+		{GeometryLineString, `{"type":"LineString","coordinates":[]}`},
+		{GeometryMultiLineString, `{"type":"MultiLineString","coordinates":[]}`},
+		{GeometryPolygon, `{"type":"Polygon","coordinates":[]}`},
+		{GeometryMultiPolygon, `{"type":"MultiPolygon","coordinates":[]}`},
+		{GeometryCollection, `{"type":"GeometryCollection","geometries":[]}`},
+	}
+
+	for _, tc := range cases {
+		t.Run(string(tc.typ), func(t *testing.T) {
+			for _, g := range []*Geometry{NewEmptyGeometry(tc.typ), {Type: tc.typ}} {
+				if !g.IsEmpty() {
+					t.Errorf("should be empty")
+				}
+
+				data, err := json.Marshal(g)
+				if err != nil {
+					t.Fatalf("should marshal to json just fine but got %v", err)
+				}
+
+				if string(data) != tc.expected {
+					t.Errorf("incorrect json, got %s", data)
+				}
+
+				// round trip
+				g, err = UnmarshalGeometry(data, Strict(), AllowEmptyGeometries())
+				if err != nil {
+					t.Fatalf("should unmarshal empty geometry, got %v", err)
+				}
+
+				if g.Type != tc.typ || !g.IsEmpty() {
+					t.Errorf("incorrect geometry, got %v", g)
+				}
+			}
+		})
+	}
+}
+
+func TestGeometryIsEmpty(t *testing.T) {
+	cases := []struct {
+		name     string
+		geometry *Geometry
+		empty    bool
+	}{
+		{"point", NewPointGeometry([]float64{1, 2}), false},
+		{"multi point of empty points", NewMultiPointGeometry([]float64{}), true},
+		{"line string", NewLineStringGeometry([][]float64{{1, 2}, {3, 4}}), false},
+		{"multi line string of empty lines", NewMultiLineStringGeometry([][]float64{}, nil), true},
+		{"polygon with empty ring", NewPolygonGeometry([][][]float64{{}}), true},
+		{"multi polygon", NewMultiPolygonGeometry(nil, [][][]float64{{{0, 0}, {1, 0}, {1, 1}, {0, 0}}}), false},
+		{"collection of empty", NewCollectionGeometry(NewEmptyGeometry(GeometryPoint), nil), true},
+		{"collection", NewCollectionGeometry(NewEmptyGeometry(GeometryPoint), NewPointGeometry([]float64{1, 2})), false},
+	}
+
+	for _, tc := range cases {
+		if v := tc.geometry.IsEmpty(); v != tc.empty {
+			t.Errorf("%s: incorrect empty, got %v", tc.name, v)
+		}
+	}
+}
+
+func BenchmarkUnmarshalGeometry(b *testing.B) {
+	ring := make([][]float64, 0, 1001)
+	for i := 0; i < 1000; i++ {
+		ring = append(ring, []float64{float64(i) / 10, float64(i%7) / 3})
+	}
+	ring = append(ring, ring[0])
+
+	data, err := NewPolygonGeometry([][][]float64{ring}).MarshalJSON()
+	if err != nil {
+		b.Fatalf("should marshal geometry, got %v", err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := UnmarshalGeometry(data); err != nil {
+			b.Fatalf("should unmarshal geometry, got %v", err)
+		}
+	}
+}
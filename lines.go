@@ -0,0 +1,126 @@
+package geojson
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// A LineError records the line of newline-delimited input that could not be decoded.
+type LineError struct {
+	Line int
+	Err  error
+}
+
+func (e *LineError) Error() string {
+	return fmt.Sprintf("line %d: %v", e.Line, e.Err)
+}
+
+// Unwrap returns the underlying decoding error.
+func (e *LineError) Unwrap() error {
+	return e.Err
+}
+
+// A LineReader reads newline-delimited GeoJSON, sometimes called GeoJSONL or GeoJSONSeq,
+// where every line of the input is a single feature.
+type LineReader struct {
+	r    *bufio.Reader
+	opts []UnmarshalOption
+	line int
+}
+
+// NewLineReader returns a new reader that reads one feature per line from r.
+func NewLineReader(r io.Reader, opts ...UnmarshalOption) *LineReader {
+	return &LineReader{r: bufio.NewReader(r), opts: opts}
+}
+
+// Next reads and decodes the feature on the next non-blank line.
+// It returns io.EOF once there are no more lines. Decoding errors are
+// returned as a *LineError and are not fatal, Next can be called again
+// to continue with the following line.
+func (l *LineReader) Next() (*Feature, error) {
+	for {
+		data, err := l.r.ReadBytes('\n')
+		if err != nil && err != io.EOF {
+			return nil, err
+		}
+
+		if len(data) == 0 && err == io.EOF {
+			return nil, io.EOF
+		}
+		l.line++
+
+		data = bytes.TrimSpace(data)
+		if len(data) == 0 {
+			continue
+		}
+
+		f, ferr := UnmarshalFeature(data, l.opts...)
+		if ferr != nil {
+			return nil, &LineError{Line: l.line, Err: ferr}
+		}
+
+		return f, nil
+	}
+}
+
+// A LineWriter writes newline-delimited GeoJSON, one compact feature per line.
+type LineWriter struct {
+	w    io.Writer
+	opts *marshalOptions
+}
+
+// NewLineWriter returns a new writer that writes one feature per line to w.
+func NewLineWriter(w io.Writer, opts ...MarshalOption) *LineWriter {
+	return &LineWriter{w: w, opts: newMarshalOptions(opts)}
+}
+
+// Encode writes the feature as the next line of the output.
+func (l *LineWriter) Encode(f *Feature) error {
+	if f == nil {
+		return errors.New("unable to write nil feature")
+	}
+
+	data, err := marshal(f, l.opts)
+	if err != nil {
+		return err
+	}
+
+	_, err = l.w.Write(append(data, '\n'))
+	return err
+}
+
+// ReadFeatureLines reads all the features of the newline-delimited input into a feature collection.
+// It stops at the first line that could not be decoded.
+func ReadFeatureLines(r io.Reader, opts ...UnmarshalOption) (*FeatureCollection, error) {
+	fc := NewFeatureCollection()
+
+	lr := NewLineReader(r, opts...)
+	for {
+		f, err := lr.Next()
+		if err == io.EOF {
+			return fc, nil
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		fc.AddFeature(f)
+	}
+}
+
+// WriteFeatureLines writes the features of the collection as newline-delimited GeoJSON.
+// Collection level members, such as the bounding box, are not written.
+func WriteFeatureLines(w io.Writer, fc *FeatureCollection, opts ...MarshalOption) error {
+	lw := NewLineWriter(w, opts...)
+	for _, f := range fc.Features {
+		if err := lw.Encode(f); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
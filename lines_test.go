@@ -0,0 +1,78 @@
+package geojson
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestLineReader(t *testing.T) {
+	rawLines := `{"type":"Feature","geometry":{"type":"Point","coordinates":[1,2]},"properties":{"prop0":"value0"}}
+
+{"type":"Feature","geometry":{"type":"Point","coordinates":"foo"},"properties":{}}
+{"type":"Feature","geometry":{"type":"Point","coordinates":[3,4]},"properties":{}}`
+
+	r := NewLineReader(strings.NewReader(rawLines))
+
+	f, err := r.Next()
+	if err != nil {
+		t.Fatalf("should read feature without issue, err %v", err)
+	}
+
+	if f.PropertyMustString("prop0") != "value0" {
+		t.Errorf("should decode properties, got %v", f.Properties)
+	}
+
+	_, err = r.Next()
+
+	var lerr *LineError
+	if !errors.As(err, &lerr) {
+		t.Fatalf("should return line error, got %v", err)
+	}
+
+	if lerr.Line != 3 {
+		t.Errorf("should report line 3, got %d", lerr.Line)
+	}
+
+	f, err = r.Next()
+	if err != nil {
+		t.Fatalf("should continue after invalid line, err %v", err)
+	}
+
+	if f.Geometry.Point[0] != 3 {
+		t.Errorf("should read last feature, got %v", f.Geometry.Point)
+	}
+
+	if _, err := r.Next(); err != io.EOF {
+		t.Errorf("should return io.EOF at the end, got %v", err)
+	}
+}
+
+func TestLineRoundTrip(t *testing.T) {
+	fc := NewFeatureCollection()
+	fc.AddFeature(NewPointFeature([]float64{1, 2}))
+	fc.AddFeature(NewLineStringFeature([][]float64{{3, 4}, {5, 6}}))
+
+	buf := &bytes.Buffer{}
+	if err := WriteFeatureLines(buf, fc); err != nil {
+		t.Fatalf("should write lines without issue, err %v", err)
+	}
+
+	expected := `{"type":"Feature","geometry":{"type":"Point","coordinates":[1,2]},"properties":{}}
+{"type":"Feature","geometry":{"type":"LineString","coordinates":[[3,4],[5,6]]},"properties":{}}
+`
+	if buf.String() != expected {
+		t.Errorf("incorrect lines, got %v", buf.String())
+	}
+
+	result, err := ReadFeatureLines(buf)
+	if err != nil {
+		t.Fatalf("should read lines without issue, err %v", err)
+	}
+
+	if len(result.Features) != 2 {
+		t.Errorf("should have 2 features but got %d", len(result.Features))
+	}
+}
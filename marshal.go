@@ -0,0 +1,209 @@
+package geojson
+
+import (
+	"math"
+	"reflect"
+	"strconv"
+)
+
+// Marshal encodes the GeoJSON object applying the options.
+// Without options the result is the same as calling MarshalJSON on the object.
+// A nil object is encoded as null.
+func Marshal(o Object, opts ...MarshalOption) ([]byte, error) {
+	return marshal(o, newMarshalOptions(opts))
+}
+
+func marshal(o Object, opts *marshalOptions) ([]byte, error) {
+	if isNilObject(o) {
+		return []byte("null"), nil
+	}
+
+	switch v := o.(type) {
+	case *Geometry:
+		o = opts.geometry(v)
+	case *Feature:
+		o = opts.feature(v)
+	case *FeatureCollection:
+		o = opts.featureCollection(v)
+	case interface{ applyMarshalOptions(*marshalOptions) Object }:
+		// FeatureOf and FeatureCollectionOf
+		o = v.applyMarshalOptions(opts)
+	}
+
+	data, err := o.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.canonical {
+		return canonicalize(data)
+	}
+
+	return data, nil
+}
+
+// isNilObject returns true for a nil interface and for a nil pointer of any of the object types.
+func isNilObject(o Object) bool {
+	if o == nil {
+		return true
+	}
+
+	v := reflect.ValueOf(o)
+	return v.Kind() == reflect.Ptr && v.IsNil()
+}
+
+// modifies returns true if the options change the objects being marshaled.
+func (o *marshalOptions) modifies() bool {
+	return o.precision >= 0 || o.fillBoundingBoxes || o.winding != 0
+}
+
+// featureCollection, feature and geometry return copies of the objects with
+// the options applied. The originals are never modified.
+func (o *marshalOptions) featureCollection(fc *FeatureCollection) *FeatureCollection {
+	if fc == nil || !o.modifies() {
+		return fc
+	}
+
+	c := *fc
+	c.BoundingBox = o.boundingBox(fc.BoundingBox)
+	c.Features = make([]*Feature, len(fc.Features))
+	for i, f := range fc.Features {
+		c.Features[i] = o.feature(f)
+	}
+
+	if o.fillBoundingBoxes {
+		c.BoundingBox = c.ComputeBoundingBox()
+	}
+
+	return &c
+}
+
+func (o *marshalOptions) feature(f *Feature) *Feature {
+	if f == nil || !o.modifies() {
+		return f
+	}
+
+	c := *f
+	c.BoundingBox = o.boundingBox(f.BoundingBox)
+	c.Geometry = o.geometry(f.Geometry)
+
+	if o.fillBoundingBoxes {
+		c.BoundingBox = c.ComputeBoundingBox()
+	}
+
+	return &c
+}
+
+func (o *marshalOptions) geometry(g *Geometry) *Geometry {
+	if g == nil || !o.modifies() {
+		return g
+	}
+
+	c := *g
+	c.BoundingBox = o.boundingBox(g.BoundingBox)
+
+	switch g.Type {
+	case GeometryPoint:
+		c.Point = o.position(g.Point)
+	case GeometryMultiPoint:
+		c.MultiPoint = o.positionSet(g.MultiPoint)
+	case GeometryLineString:
+		c.LineString = o.positionSet(g.LineString)
+	case GeometryMultiLineString:
+		c.MultiLineString = o.pathSet(g.MultiLineString)
+	case GeometryPolygon:
+		c.Polygon = o.pathSet(g.Polygon)
+	case GeometryMultiPolygon:
+		if g.MultiPolygon != nil {
+			c.MultiPolygon = make([][][][]float64, len(g.MultiPolygon))
+			for i, p := range g.MultiPolygon {
+				c.MultiPolygon[i] = o.pathSet(p)
+			}
+		}
+	case GeometryCollection:
+		if g.Geometries != nil {
+			c.Geometries = make([]*Geometry, len(g.Geometries))
+			for i, child := range g.Geometries {
+				c.Geometries[i] = o.geometry(child)
+			}
+		}
+	}
+
+	if o.winding != 0 && g.Type != GeometryCollection {
+		// the rings have already been copied by pathSet
+		c.Rewind(o.winding)
+	}
+
+	if o.fillBoundingBoxes {
+		c.BoundingBox = c.ComputeBoundingBox()
+	}
+
+	return &c
+}
+
+func (o *marshalOptions) pathSet(paths [][][]float64) [][][]float64 {
+	if paths == nil {
+		return nil
+	}
+
+	result := make([][][]float64, len(paths))
+	for i, p := range paths {
+		result[i] = o.positionSet(p)
+	}
+
+	return result
+}
+
+func (o *marshalOptions) positionSet(positions [][]float64) [][]float64 {
+	if positions == nil {
+		return nil
+	}
+
+	result := make([][]float64, len(positions))
+	for i, p := range positions {
+		result[i] = o.position(p)
+	}
+
+	return result
+}
+
+func (o *marshalOptions) boundingBox(bb []float64) []float64 {
+	if bb == nil || o.precision < 0 {
+		return bb
+	}
+
+	return o.position(bb)
+}
+
+func (o *marshalOptions) position(p []float64) []float64 {
+	if p == nil || o.precision < 0 {
+		return p
+	}
+
+	result := make([]float64, len(p))
+	for i, v := range p {
+		result[i] = round(v, o.precision)
+	}
+
+	return result
+}
+
+// round rounds the value to n decimal places. Formatting as a decimal string
+// avoids the binary representation errors of scaling by a power of ten.
+func round(v float64, n int) float64 {
+	if math.IsNaN(v) || math.IsInf(v, 0) {
+		return v
+	}
+
+	r, err := strconv.ParseFloat(strconv.FormatFloat(v, 'f', n, 64), 64)
+	if err != nil {
+		return v
+	}
+
+	if r == 0 {
+		// avoid writing small negative values as -0
+		return 0
+	}
+
+	return r
+}
@@ -0,0 +1,120 @@
+package geojson
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestMarshalPrecision(t *testing.T) {
+	g := NewCollectionGeometry(
+		NewPointGeometry([]float64{1.123456789, -0.0000001}),
+		NewPolygonGeometry([][][]float64{{{1.005, 2.4999}, {3.1, 4.2}, {5.55555, 6}, {1.005, 2.4999}}}),
+	)
+	g.BoundingBox = []float64{1.123456789, 0.333333, 5.55555, 6.1}
+
+	f := NewFeature(g)
+	f.BoundingBox = []float64{1.123456789, 0.333333, 5.55555, 6.1}
+
+	fc := NewFeatureCollection().AddFeature(f)
+	fc.BoundingBox = []float64{1.123456789, 0.333333, 5.55555, 6.1}
+
+	data, err := Marshal(fc, Precision(2))
+	if err != nil {
+		t.Fatalf("should marshal to json just fine but got %v", err)
+	}
+
+	expected := `{"type":"FeatureCollection","bbox":[1.12,0.33,5.56,6.1],"features":[` +
+		`{"type":"Feature","bbox":[1.12,0.33,5.56,6.1],"geometry":{"type":"GeometryCollection","bbox":[1.12,0.33,5.56,6.1],"geometries":[` +
+		`{"type":"Point","coordinates":[1.12,0]},` +
+		`{"type":"Polygon","coordinates":[[[1,2.5],[3.1,4.2],[5.56,6],[1,2.5]]]}]},"properties":{}}]}`
+	if string(data) != expected {
+		t.Errorf("incorrect json, got %v", string(data))
+	}
+
+	if g.Geometries[0].Point[0] != 1.123456789 || fc.BoundingBox[0] != 1.123456789 {
+		t.Errorf("should not modify the original values")
+	}
+}
+
+func TestMarshalNoOptions(t *testing.T) {
+	f := NewPointFeature([]float64{1.123456789, 2})
+	f.SetProperty("a", 1.123456789)
+
+	data, err := Marshal(f)
+	if err != nil {
+		t.Fatalf("should marshal to json just fine but got %v", err)
+	}
+
+	expected, _ := f.MarshalJSON()
+	if !bytes.Equal(data, expected) {
+		t.Errorf("should match MarshalJSON, got %v", string(data))
+	}
+}
+
+func TestMarshalNil(t *testing.T) {
+	for _, o := range []Object{nil, (*Geometry)(nil), (*Feature)(nil), (*FeatureCollection)(nil)} {
+		data, err := Marshal(o, Precision(2), Canonical())
+		if err != nil {
+			t.Fatalf("should marshal nil object, got %v", err)
+		}
+
+		if string(data) != "null" {
+			t.Errorf("should marshal nil object as null, got %v", string(data))
+		}
+	}
+}
+
+func TestEncoderPrecision(t *testing.T) {
+	buf := &bytes.Buffer{}
+
+	e := NewFeatureCollectionEncoder(buf, Precision(1))
+	e.BoundingBox = []float64{1.11, 2.22, 3.33, 4.44}
+	e.AddFeature(NewPointFeature([]float64{1.11, 2.22}))
+	e.Close()
+
+	expected := `{"type":"FeatureCollection","bbox":[1.1,2.2,3.3,4.4],"features":[{"type":"Feature","geometry":{"type":"Point","coordinates":[1.1,2.2]},"properties":{}}]}`
+	if buf.String() != expected {
+		t.Errorf("incorrect json, got %v", buf.String())
+	}
+
+	buf.Reset()
+	NewLineWriter(buf, Precision(0)).Encode(NewPointFeature([]float64{1.6, 2.2}))
+	if buf.String() != `{"type":"Feature","geometry":{"type":"Point","coordinates":[2,2]},"properties":{}}`+"\n" {
+		t.Errorf("incorrect line, got %v", buf.String())
+	}
+}
+
+func TestMarshalFillBoundingBoxes(t *testing.T) {
+	f := NewLineStringFeature([][]float64{{1.23456, 2}, {3, 4.56789}})
+
+	data, err := Marshal(f, FillBoundingBoxes(), Precision(2))
+	if err != nil {
+		t.Fatalf("should marshal to json just fine but got %v", err)
+	}
+
+	expected := `{"type":"Feature","bbox":[1.23,2,3,4.57],"geometry":{"type":"LineString","bbox":[1.23,2,3,4.57],"coordinates":[[1.23,2],[3,4.57]]},"properties":{}}`
+	if string(data) != expected {
+		t.Errorf("incorrect json, got %s", data)
+	}
+
+	if f.BoundingBox != nil || f.Geometry.BoundingBox != nil {
+		t.Errorf("should not modify the feature")
+	}
+}
+
+func TestMarshalEnforceWinding(t *testing.T) {
+	g := NewPolygonGeometry([][][]float64{{{0, 0}, {0, 1}, {1, 1}, {0, 0}}})
+
+	data, err := Marshal(g, EnforceWinding(WindingRFC7946))
+	if err != nil {
+		t.Fatalf("should marshal to json just fine but got %v", err)
+	}
+
+	if string(data) != `{"type":"Polygon","coordinates":[[[0,0],[1,1],[0,1],[0,0]]]}` {
+		t.Errorf("incorrect json, got %s", data)
+	}
+
+	if g.Polygon[0][1][0] != 0 {
+		t.Errorf("should not modify the geometry")
+	}
+}
@@ -0,0 +1,147 @@
+package geojson
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// An Object is a GeoJSON object, one of *Geometry, *Feature or *FeatureCollection,
+// or the typed *FeatureOf and *FeatureCollectionOf.
+type Object interface {
+	json.Marshaler
+
+	// GeoJSONType returns the value of the type member of the object.
+	GeoJSONType() string
+}
+
+// GeoJSONType returns the type of the geometry, e.g. Point.
+func (g *Geometry) GeoJSONType() string {
+	return string(g.Type)
+}
+
+// GeoJSONType returns Feature, the type of all feature objects.
+func (f *Feature) GeoJSONType() string {
+	return "Feature"
+}
+
+// GeoJSONType returns FeatureCollection, the type of all feature collection objects.
+func (fc *FeatureCollection) GeoJSONType() string {
+	return "FeatureCollection"
+}
+
+// Unmarshal decodes the data into the GeoJSON object given by its type member.
+// The result will be a *Feature, *FeatureCollection or, for any other type, a *Geometry.
+// A type switch can be used to determine which:
+//
+//	switch o := obj.(type) {
+//	case *geojson.Geometry:
+//	case *geojson.Feature:
+//	case *geojson.FeatureCollection:
+//	}
+func Unmarshal(data []byte, opts ...UnmarshalOption) (Object, error) {
+	t, err := sniffType(data)
+	if err != nil {
+		return nil, err
+	}
+
+	// the typed results are checked so a failure is never returned as
+	// a non-nil Object holding a nil pointer
+	switch t {
+	case "Feature":
+		f, err := UnmarshalFeature(data, opts...)
+		if err != nil {
+			return nil, err
+		}
+		return f, nil
+	case "FeatureCollection":
+		fc, err := UnmarshalFeatureCollection(data, opts...)
+		if err != nil {
+			return nil, err
+		}
+		return fc, nil
+	default:
+		g, err := UnmarshalGeometry(data, opts...)
+		if err != nil {
+			return nil, err
+		}
+		return g, nil
+	}
+}
+
+// AsFeatureCollection normalizes the object into a feature collection.
+// A geometry is wrapped in a feature, a feature is added to a new collection
+// and a feature collection is returned as is. A FeatureOf or FeatureCollectionOf
+// is converted into an untyped feature, which can fail if its properties cannot be encoded.
+func AsFeatureCollection(o Object) (*FeatureCollection, error) {
+	switch o := o.(type) {
+	case *FeatureCollection:
+		return o, nil
+	case *Feature:
+		return NewFeatureCollection().AddFeature(o), nil
+	case *Geometry:
+		return NewFeatureCollection().AddFeature(NewFeature(o)), nil
+	case featureCollectionConverter:
+		return o.ToFeatureCollection()
+	case featureConverter:
+		f, err := o.ToFeature()
+		if err != nil {
+			return nil, err
+		}
+		return NewFeatureCollection().AddFeature(f), nil
+	}
+
+	return NewFeatureCollection(), nil
+}
+
+// featureConverter and featureCollectionConverter are implemented by the typed
+// FeatureOf and FeatureCollectionOf for any type of properties.
+type featureConverter interface {
+	ToFeature() (*Feature, error)
+}
+
+type featureCollectionConverter interface {
+	ToFeatureCollection() (*FeatureCollection, error)
+}
+
+// sniffType returns the value of the top level type member of the JSON object.
+func sniffType(data []byte) (string, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+
+	t, err := dec.Token()
+	if err != nil {
+		return "", err
+	}
+
+	if t != json.Delim('{') {
+		return "", &DecodeError{Expected: "GeoJSON object", Actual: jsonType(t)}
+	}
+
+	for dec.More() {
+		t, err := dec.Token()
+		if err != nil {
+			return "", err
+		}
+
+		if t != "type" {
+			var skip json.RawMessage
+			if err := dec.Decode(&skip); err != nil {
+				return "", err
+			}
+			continue
+		}
+
+		var v interface{}
+		if err := dec.Decode(&v); err != nil {
+			return "", err
+		}
+
+		s, ok := v.(string)
+		if !ok {
+			return "", &DecodeError{Path: "type", Expected: "string", Actual: jsonType(v)}
+		}
+
+		return s, nil
+	}
+
+	return "", &DecodeError{Path: "type", Expected: "string", Actual: "missing"}
+}
@@ -0,0 +1,87 @@
+package geojson
+
+import (
+	"testing"
+)
+
+func TestUnmarshal(t *testing.T) {
+	cases := []struct {
+		name     string
+		data     string
+		typ      string
+		features int
+	}{
+		{
+			name:     "geometry",
+			data:     `{"coordinates": [1, 2], "type": "Point"}`,
+			typ:      "Point",
+			features: 1,
+		},
+		{
+			name:     "feature",
+			data:     `{"type": "Feature", "geometry": {"type": "Point", "coordinates": [1, 2]}, "properties": {}}`,
+			typ:      "Feature",
+			features: 1,
+		},
+		{
+			name: "feature collection",
+			data: `{"features": [
+				{"type": "Feature", "geometry": null, "properties": {}},
+				{"type": "Feature", "geometry": null, "properties": {}}
+			], "type": "FeatureCollection"}`,
+			typ:      "FeatureCollection",
+			features: 2,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			o, err := Unmarshal([]byte(tc.data))
+			if err != nil {
+				t.Fatalf("should unmarshal without issue, err %v", err)
+			}
+
+			if o.GeoJSONType() != tc.typ {
+				t.Errorf("incorrect type, got %v", o.GeoJSONType())
+			}
+
+			fc, err := AsFeatureCollection(o)
+			if err != nil {
+				t.Fatalf("should convert without issue, err %v", err)
+			}
+
+			if len(fc.Features) != tc.features {
+				t.Errorf("should have %d features but got %d", tc.features, len(fc.Features))
+			}
+		})
+	}
+}
+
+func TestUnmarshalErrors(t *testing.T) {
+	if _, err := Unmarshal([]byte(`[1, 2]`)); err == nil {
+		t.Errorf("should return error if not an object")
+	}
+
+	if _, err := Unmarshal([]byte(`{"coordinates": [1, 2]}`)); err == nil {
+		t.Errorf("should return error if type is missing")
+	}
+
+	if _, err := Unmarshal([]byte(`{"type": "Circle"}`), Strict()); err == nil {
+		t.Errorf("should return error for unknown type in strict mode")
+	}
+
+	for _, data := range []string{
+		`{"type": "Feature", "geometry": 1}`,
+		`{"type": "FeatureCollection", "features": 1}`,
+		`{"type": "Point", "coordinates": 1}`,
+	} {
+		o, err := Unmarshal([]byte(data))
+		if err == nil {
+			t.Errorf("should return error for %s", data)
+		}
+
+		if o != nil {
+			t.Errorf("should return nil object on error, got %#v", o)
+		}
+	}
+}
@@ -0,0 +1,123 @@
+package geojson
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// An UnmarshalOption configures optional decoding behavior. Options can be passed to
+// the Unmarshal functions as well as the streaming readers and decoders.
+type UnmarshalOption func(*unmarshalOptions)
+
+type unmarshalOptions struct {
+	strict     bool
+	useNumber  bool
+	allowEmpty bool
+}
+
+// Strict enables strict decoding. Input that is not valid RFC 7946 GeoJSON,
+// such as unknown geometry types, positions with less than two or more than three elements,
+// line strings with a single position and unclosed linear rings, returns an error.
+func Strict() UnmarshalOption {
+	return func(o *unmarshalOptions) {
+		o.strict = true
+	}
+}
+
+// AllowEmptyGeometries accepts points and line strings with empty coordinates in strict mode.
+// These are empty geometries, as written by MarshalJSON for NewEmptyGeometry,
+// but are otherwise rejected for having too few numbers or positions.
+func AllowEmptyGeometries() UnmarshalOption {
+	return func(o *unmarshalOptions) {
+		o.allowEmpty = true
+	}
+}
+
+// UseNumber decodes numbers in feature ids, properties and foreign members as json.Number
+// instead of float64. This preserves integers larger than 2^53, such as 64-bit ids,
+// which can then be read using Feature.IDInt64 and Feature.PropertyInt64.
+func UseNumber() UnmarshalOption {
+	return func(o *unmarshalOptions) {
+		o.useNumber = true
+	}
+}
+
+func newUnmarshalOptions(opts []UnmarshalOption) *unmarshalOptions {
+	o := &unmarshalOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	return o
+}
+
+// unmarshalValue decodes free form values such as ids, properties and foreign members.
+func (o *unmarshalOptions) unmarshalValue(data []byte, v interface{}) error {
+	if !o.useNumber {
+		return json.Unmarshal(data, v)
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+
+	return dec.Decode(v)
+}
+
+// A MarshalOption configures optional encoding behavior. Options can be passed to
+// Marshal as well as the streaming writers and encoders.
+type MarshalOption func(*marshalOptions)
+
+type marshalOptions struct {
+	precision         int
+	canonical         bool
+	fillBoundingBoxes bool
+	winding           Winding
+}
+
+// Precision rounds coordinates and bounding box values to the given number of decimal places.
+// Six decimal places is about 10 centimeters at the equator, which is usually plenty.
+func Precision(n int) MarshalOption {
+	return func(o *marshalOptions) {
+		o.precision = n
+	}
+}
+
+// Canonical writes the JSON following the JSON Canonicalization Scheme of RFC 8785.
+// Object members are sorted, numbers are written in their shortest form and strings
+// only escape what is required, so the output for equivalent objects is byte-identical.
+// Numbers are written as doubles, as RFC 8785 requires, so marshaling returns an error
+// for integers that cannot be represented exactly, such as 64-bit ids decoded with UseNumber.
+// The FeatureCollectionEncoder writes the collection members in a fixed order,
+// so only the individual features it writes are canonical.
+func Canonical() MarshalOption {
+	return func(o *marshalOptions) {
+		o.canonical = true
+	}
+}
+
+// FillBoundingBoxes sets the bbox of every geometry, feature and feature collection
+// to the one computed from its positions, see Geometry.ComputeBoundingBox.
+// The FeatureCollectionEncoder writes the collection bbox before the features,
+// so only the bboxes of the features it writes are filled.
+func FillBoundingBoxes() MarshalOption {
+	return func(o *marshalOptions) {
+		o.fillBoundingBoxes = true
+	}
+}
+
+// EnforceWinding rewinds the rings of polygons and multi-polygons to follow
+// the winding convention, see Geometry.Rewind.
+func EnforceWinding(w Winding) MarshalOption {
+	return func(o *marshalOptions) {
+		o.winding = w
+	}
+}
+
+func newMarshalOptions(opts []MarshalOption) *marshalOptions {
+	o := &marshalOptions{precision: -1}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	return o
+}
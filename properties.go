@@ -1,7 +1,10 @@
 package geojson
 
 import (
+	"encoding/json"
 	"fmt"
+	"math"
+	"strconv"
 )
 
 // SetProperty provides the inverse of all the property functions
@@ -22,23 +25,42 @@ func (f *Feature) PropertyBool(key string) (bool, error) {
 }
 
 // PropertyInt type asserts a property to `int`.
+// Floats and json.Number, as decoded with the UseNumber option, are only converted
+// if they are integers that fit in an int, they are never truncated.
 func (f *Feature) PropertyInt(key string) (int, error) {
 	if i, ok := (f.Properties[key]).(int); ok {
 		return i, nil
 	}
 
-	if i, ok := (f.Properties[key]).(float64); ok {
+	if i, ok := toInt64(f.Properties[key]); ok && int64(int(i)) == i {
 		return int(i), nil
 	}
 
 	return 0, fmt.Errorf("type assertion of `%s` to int failed", key)
 }
 
+// PropertyInt64 type asserts a property to `int64`.
+// Floats are only converted if they are integers, use the UseNumber option
+// when decoding to read integers larger than 2^53 exactly.
+func (f *Feature) PropertyInt64(key string) (int64, error) {
+	if i, ok := toInt64(f.Properties[key]); ok {
+		return i, nil
+	}
+
+	return 0, fmt.Errorf("type assertion of `%s` to int64 failed", key)
+}
+
 // PropertyFloat64 type asserts a property to `float64`.
+// Integer types and json.Number, as decoded with the UseNumber option, are converted.
 func (f *Feature) PropertyFloat64(key string) (float64, error) {
 	if i, ok := (f.Properties[key]).(float64); ok {
 		return i, nil
 	}
+
+	if i, ok := toFloat64(f.Properties[key]); ok {
+		return i, nil
+	}
+
 	return 0, fmt.Errorf("type assertion of `%s` to float64 failed", key)
 }
 
@@ -88,6 +110,25 @@ func (f *Feature) PropertyMustInt(key string, def ...int) int {
 	return defaul
 }
 
+// PropertyMustInt64 guarantees the return of a `int64` (with optional default)
+//
+// useful when you explicitly want a `int64` in a single value return context:
+//     myFunc(f.PropertyMustInt64("param1"), f.PropertyMustInt64("optional_param", 123))
+func (f *Feature) PropertyMustInt64(key string, def ...int64) int64 {
+	var defaul int64
+
+	b, err := f.PropertyInt64(key)
+	if err == nil {
+		return b
+	}
+
+	if len(def) > 0 {
+		defaul = def[0]
+	}
+
+	return defaul
+}
+
 // PropertyMustFloat64 guarantees the return of a `float64` (with optional default)
 //
 // useful when you explicitly want a `float64` in a single value return context:
@@ -125,3 +166,70 @@ func (f *Feature) PropertyMustString(key string, def ...string) string {
 
 	return defaul
 }
+
+// toInt64 converts integer values, integral floats and json.Number into an int64.
+func toInt64(v interface{}) (int64, bool) {
+	switch n := v.(type) {
+	case int:
+		return int64(n), true
+	case int8:
+		return int64(n), true
+	case int16:
+		return int64(n), true
+	case int32:
+		return int64(n), true
+	case int64:
+		return n, true
+	case uint:
+		return int64(n), uint64(n) <= math.MaxInt64
+	case uint8:
+		return int64(n), true
+	case uint16:
+		return int64(n), true
+	case uint32:
+		return int64(n), true
+	case uint64:
+		return int64(n), n <= math.MaxInt64
+	case float32:
+		return toInt64(float64(n))
+	case float64:
+		if n != math.Trunc(n) || n < math.MinInt64 || n >= math.MaxInt64 {
+			return 0, false
+		}
+		return int64(n), true
+	case json.Number:
+		if i, err := n.Int64(); err == nil {
+			return i, true
+		}
+
+		// e.g. 1e3 is an integer but not parsed by Int64
+		if f, err := n.Float64(); err == nil {
+			return toInt64(f)
+		}
+	}
+
+	return 0, false
+}
+
+// toFloat64 converts any number type and json.Number into a float64.
+func toFloat64(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case float64:
+		return n, true
+	case float32:
+		return float64(n), true
+	case json.Number:
+		f, err := strconv.ParseFloat(string(n), 64)
+		return f, err == nil
+	}
+
+	if i, ok := toInt64(v); ok {
+		return float64(i), true
+	}
+
+	if u, ok := v.(uint64); ok {
+		return float64(u), true
+	}
+
+	return 0, false
+}
@@ -0,0 +1,200 @@
+package geojson
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// A PropertiesError is returned by DecodeProperties if some of the struct fields
+// could not be set from the feature properties. All the other fields are still set.
+type PropertiesError struct {
+	// Missing lists the keys of the required fields that are not in the properties.
+	Missing []string
+
+	// Mismatched lists the keys whose values could not be decoded into the field's type.
+	// For nested structs the key is followed by the path of the mismatched value, e.g. "address.zip".
+	Mismatched []string
+}
+
+func (e *PropertiesError) Error() string {
+	var parts []string
+	if len(e.Missing) != 0 {
+		parts = append(parts, "missing "+strings.Join(e.Missing, ", "))
+	}
+
+	if len(e.Mismatched) != 0 {
+		parts = append(parts, "mismatched "+strings.Join(e.Mismatched, ", "))
+	}
+
+	return "properties: " + strings.Join(parts, "; ")
+}
+
+// DecodeProperties sets the fields of the struct pointed to by v from the feature properties.
+// Fields are matched using their json tags, and values are converted following the
+// encoding/json rules, so nested structs, slices and time.Time are supported.
+// Pointer fields and fields tagged omitempty are optional, any other field
+// without a property is reported as missing in the returned *PropertiesError.
+func (f *Feature) DecodeProperties(v interface{}) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("decode properties into %T: must be a non-nil pointer to a struct", v)
+	}
+	rv = rv.Elem()
+
+	perr := &PropertiesError{}
+	for _, field := range structFields(rv.Type()) {
+		value, ok := f.Properties[field.name]
+		if !ok {
+			if !field.optional {
+				perr.Missing = append(perr.Missing, field.name)
+			}
+			continue
+		}
+
+		if err := setField(rv.FieldByIndex(field.index), value); err != nil {
+			key := field.name
+			var ute *json.UnmarshalTypeError
+			if errors.As(err, &ute) && ute.Field != "" {
+				key += "." + ute.Field
+			}
+
+			perr.Mismatched = append(perr.Mismatched, key)
+		}
+	}
+
+	if len(perr.Missing) != 0 || len(perr.Mismatched) != 0 {
+		return perr
+	}
+
+	return nil
+}
+
+// SetPropertiesFrom replaces the feature properties with the fields of the struct v,
+// or the struct it points to. Fields are named using their json tags and empty
+// omitempty fields are skipped. The values are stored as is, e.g. an int field
+// is stored as an int, and encoded by encoding/json when marshaling.
+func (f *Feature) SetPropertiesFrom(v interface{}) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() == reflect.Ptr && !rv.IsNil() {
+		rv = rv.Elem()
+	}
+
+	if rv.Kind() != reflect.Struct {
+		return fmt.Errorf("set properties from %T: must be a struct or a pointer to a struct", v)
+	}
+
+	properties := make(map[string]interface{})
+	for _, field := range structFields(rv.Type()) {
+		value := rv.FieldByIndex(field.index)
+		if field.omitEmpty && isEmptyValue(value) {
+			continue
+		}
+
+		if value.Kind() == reflect.Ptr && value.IsNil() {
+			properties[field.name] = nil
+			continue
+		}
+
+		properties[field.name] = value.Interface()
+	}
+
+	f.Properties = properties
+	return nil
+}
+
+type structField struct {
+	name      string
+	index     []int
+	omitEmpty bool
+	optional  bool
+}
+
+// structFields returns the exported fields of the struct as named by their json tags.
+// The fields of embedded structs without a tag are included as if they were in the outer struct.
+// Fields with a geojson tag are not properties and are skipped.
+func structFields(t reflect.Type) []structField {
+	var fields []structField
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+
+		tag := sf.Tag.Get("json")
+		if tag == "-" || sf.Tag.Get("geojson") != "" {
+			continue
+		}
+
+		name, opts, _ := strings.Cut(tag, ",")
+		if sf.Anonymous && name == "" && sf.Type.Kind() == reflect.Struct {
+			for _, field := range structFields(sf.Type) {
+				field.index = append([]int{i}, field.index...)
+				fields = append(fields, field)
+			}
+			continue
+		}
+
+		if !sf.IsExported() {
+			continue
+		}
+
+		if name == "" {
+			name = sf.Name
+		}
+
+		omitEmpty := strings.Contains(","+opts+",", ",omitempty,")
+		fields = append(fields, structField{
+			name:      name,
+			index:     []int{i},
+			omitEmpty: omitEmpty,
+			optional:  omitEmpty || sf.Type.Kind() == reflect.Ptr,
+		})
+	}
+
+	return fields
+}
+
+// setField sets the field to the property value, converting it using its JSON encoding
+// if it can not be assigned directly. As with encoding/json, the valid parts of
+// a nested value are still set if some of it is mismatched.
+func setField(field reflect.Value, value interface{}) error {
+	if value != nil {
+		if rv := reflect.ValueOf(value); rv.Type().AssignableTo(field.Type()) {
+			field.Set(rv)
+			return nil
+		}
+	}
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+
+	ptr := reflect.New(field.Type())
+	err = json.Unmarshal(data, ptr.Interface())
+	if _, ok := err.(*json.UnmarshalTypeError); err == nil || ok {
+		field.Set(ptr.Elem())
+	}
+
+	return err
+}
+
+// isEmptyValue matches the definition of empty used by the omitempty json option.
+func isEmptyValue(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
+		return v.Len() == 0
+	case reflect.Bool:
+		return !v.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int() == 0
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return v.Uint() == 0
+	case reflect.Float32, reflect.Float64:
+		return v.Float() == 0
+	case reflect.Interface, reflect.Ptr:
+		return v.IsNil()
+	}
+
+	return false
+}
@@ -0,0 +1,152 @@
+package geojson
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+type testAddress struct {
+	Street string `json:"street"`
+	Zip    int    `json:"zip"`
+}
+
+type testBase struct {
+	Name string `json:"name"`
+}
+
+type testPlace struct {
+	testBase
+	Population int          `json:"population"`
+	Founded    time.Time    `json:"founded"`
+	Address    testAddress  `json:"address"`
+	Mayor      *string      `json:"mayor"`
+	Tags       []string     `json:"tags,omitempty"`
+	Ignored    string       `json:"-"`
+	Capital    bool         `json:"capital,omitempty"`
+	Previous   *testAddress `json:"previous"`
+}
+
+func TestFeatureDecodeProperties(t *testing.T) {
+	rawJSON := `{"type": "Feature", "geometry": null, "properties": {
+		"name": "Springfield", "population": 30720, "founded": "1796-07-04T00:00:00Z",
+		"address": {"street": "Main", "zip": 12345}, "mayor": null, "tags": ["a", "b"], "Ignored": "x"
+	}}`
+
+	f, err := UnmarshalFeature([]byte(rawJSON))
+	if err != nil {
+		t.Fatalf("should unmarshal feature without issue, err %v", err)
+	}
+
+	var p testPlace
+	if err := f.DecodeProperties(&p); err != nil {
+		t.Fatalf("should decode properties, got %v", err)
+	}
+
+	expected := testPlace{
+		testBase:   testBase{Name: "Springfield"},
+		Population: 30720,
+		Founded:    time.Date(1796, 7, 4, 0, 0, 0, 0, time.UTC),
+		Address:    testAddress{Street: "Main", Zip: 12345},
+		Tags:       []string{"a", "b"},
+	}
+	if !reflect.DeepEqual(p, expected) {
+		t.Errorf("incorrect properties, got %+v", p)
+	}
+
+	if err := f.DecodeProperties(p); err == nil {
+		t.Errorf("should return error for non pointer")
+	}
+}
+
+func TestFeatureDecodePropertiesErrors(t *testing.T) {
+	f := NewFeature(nil)
+	f.SetProperty("name", 1)
+	f.SetProperty("population", 1.5)
+	f.SetProperty("address", map[string]interface{}{"street": "Main", "zip": "12345"})
+
+	var p testPlace
+	err := f.DecodeProperties(&p)
+
+	var perr *PropertiesError
+	if !errors.As(err, &perr) {
+		t.Fatalf("should return properties error, got %v", err)
+	}
+
+	if !reflect.DeepEqual(perr.Missing, []string{"founded"}) {
+		t.Errorf("incorrect missing, got %v", perr.Missing)
+	}
+
+	if !reflect.DeepEqual(perr.Mismatched, []string{"name", "population", "address.zip"}) {
+		t.Errorf("incorrect mismatched, got %v", perr.Mismatched)
+	}
+
+	if err.Error() != "properties: missing founded; mismatched name, population, address.zip" {
+		t.Errorf("incorrect error message, got %v", err)
+	}
+
+	if p.Address.Street != "Main" {
+		t.Errorf("should still set the valid fields, got %v", p.Address)
+	}
+}
+
+func TestFeatureSetPropertiesFrom(t *testing.T) {
+	mayor := "Quimby"
+	p := &testPlace{
+		testBase:   testBase{Name: "Springfield"},
+		Population: 30720,
+		Founded:    time.Date(1796, 7, 4, 0, 0, 0, 0, time.UTC),
+		Mayor:      &mayor,
+		Ignored:    "x",
+	}
+
+	f := NewFeature(nil)
+	f.SetProperty("old", true)
+	if err := f.SetPropertiesFrom(p); err != nil {
+		t.Fatalf("should set properties, got %v", err)
+	}
+
+	if len(f.Properties) != 6 {
+		t.Errorf("incorrect number of properties, got %v", f.Properties)
+	}
+
+	if f.PropertyMustString("name") != "Springfield" || f.PropertyMustInt("population") != 30720 {
+		t.Errorf("incorrect properties, got %v", f.Properties)
+	}
+
+	if v, ok := f.Properties["previous"]; !ok || v != nil {
+		t.Errorf("nil pointer should be a null property, got %v", v)
+	}
+
+	data, err := json.Marshal(f)
+	if err != nil {
+		t.Fatalf("should marshal to json just fine but got %v", err)
+	}
+
+	expected := `{"type":"Feature","geometry":null,"properties":{"address":{"street":"","zip":0},"founded":"1796-07-04T00:00:00Z","mayor":"Quimby","name":"Springfield","population":30720,"previous":null}}`
+	if string(data) != expected {
+		t.Errorf("incorrect json, got %s", data)
+	}
+
+	// round trip through json
+	f, err = UnmarshalFeature(data)
+	if err != nil {
+		t.Fatalf("should unmarshal feature without issue, err %v", err)
+	}
+
+	var back testPlace
+	if err := f.DecodeProperties(&back); err != nil {
+		t.Fatalf("should decode properties, got %v", err)
+	}
+
+	p.Ignored = ""
+	if !reflect.DeepEqual(&back, p) {
+		t.Errorf("incorrect round trip, got %+v", back)
+	}
+
+	if err := f.SetPropertiesFrom(1); err == nil {
+		t.Errorf("should return error for non struct")
+	}
+}
@@ -59,6 +59,10 @@ func TestFeaturePropertyInt(t *testing.T) {
 	if i != 1 {
 		t.Errorf("should return proper property")
 	}
+
+	if _, err := f.PropertyInt("float64"); err == nil {
+		t.Errorf("should not truncate non integer numbers")
+	}
 }
 
 func TestFeaturePropertyFloat64(t *testing.T) {
@@ -142,9 +146,15 @@ func TestFeaturePropertyMustInt(t *testing.T) {
 		t.Errorf("should work for true integer types")
 	}
 
-	i = f.PropertyMustInt("float64")
-	if i != 1 {
-		t.Errorf("should convert float64 to int")
+	f.SetProperty("integer_float64", 2.0)
+	i = f.PropertyMustInt("integer_float64")
+	if i != 2 {
+		t.Errorf("should convert integer float64 to int")
+	}
+
+	i = f.PropertyMustInt("float64", 10)
+	if i != 10 {
+		t.Errorf("should not truncate float64, got %v", i)
 	}
 }
 
@@ -185,3 +195,40 @@ func TestFeaturePropertyMustString(t *testing.T) {
 		t.Errorf("should return proper property, without default")
 	}
 }
+
+func TestFeaturePropertyUseNumber(t *testing.T) {
+	rawJSON := `
+	  { "type": "Feature",
+	    "geometry": {"type": "Point", "coordinates": [102.0, 0.5]},
+	    "properties": {"big": 9007199254740993, "int": 1, "float64": 1.2, "exp": 1e3}
+	  }`
+
+	f, err := UnmarshalFeature([]byte(rawJSON), UseNumber())
+	if err != nil {
+		t.Fatalf("should unmarshal feature without issue, err %v", err)
+	}
+
+	if i, err := f.PropertyInt64("big"); err != nil || i != 9007199254740993 {
+		t.Errorf("should return exact int64, got %v %v", i, err)
+	}
+
+	if i, err := f.PropertyInt("int"); err != nil || i != 1 {
+		t.Errorf("should return int, got %v %v", i, err)
+	}
+
+	if i, err := f.PropertyInt("exp"); err != nil || i != 1000 {
+		t.Errorf("should return int for exponent, got %v %v", i, err)
+	}
+
+	if _, err := f.PropertyInt("float64"); err == nil {
+		t.Errorf("should not truncate non integer numbers")
+	}
+
+	if v, err := f.PropertyFloat64("float64"); err != nil || v != 1.2 {
+		t.Errorf("should return float64, got %v %v", v, err)
+	}
+
+	if v := f.PropertyMustInt64("float64", 5); v != 5 {
+		t.Errorf("should return default, got %v", v)
+	}
+}
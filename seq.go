@@ -0,0 +1,111 @@
+package geojson
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// recordSeparator starts every GeoJSON text in a sequence, see RFC 7464 and RFC 8142.
+const recordSeparator = 0x1E
+
+// ErrTruncatedText is returned, possibly wrapped, by SeqReader.Next
+// when a text in the sequence is truncated or otherwise not valid JSON.
+var ErrTruncatedText = errors.New("truncated or invalid text in sequence")
+
+// A SeqReader reads GeoJSON text sequences as defined by RFC 8142,
+// i.e. application/geo+json-seq content.
+type SeqReader struct {
+	r       *bufio.Reader
+	opts    []UnmarshalOption
+	started bool
+}
+
+// NewSeqReader returns a new reader that reads a GeoJSON text sequence from r.
+func NewSeqReader(r io.Reader, opts ...UnmarshalOption) *SeqReader {
+	return &SeqReader{r: bufio.NewReader(r), opts: opts}
+}
+
+// Next reads and decodes the next text of the sequence. The result will be
+// a *Feature, *Geometry or *FeatureCollection depending on the type of the text,
+// see Unmarshal.
+// It returns io.EOF once there are no more texts. Errors decoding a single text,
+// including ErrTruncatedText, are not fatal and Next can be called again to
+// continue with the following text, as RFC 7464 requires.
+func (s *SeqReader) Next() (Object, error) {
+	if !s.started {
+		s.started = true
+
+		// anything before the first record separator is not part of the sequence
+		data, err := s.r.ReadBytes(recordSeparator)
+		if err != nil && err != io.EOF {
+			return nil, err
+		}
+
+		if err == io.EOF {
+			if len(bytes.TrimSpace(data)) != 0 {
+				return nil, fmt.Errorf("%w: missing record separator", ErrTruncatedText)
+			}
+			return nil, io.EOF
+		}
+	}
+
+	for {
+		data, err := s.r.ReadBytes(recordSeparator)
+		if err != nil && err != io.EOF {
+			return nil, err
+		}
+
+		if err == nil {
+			data = data[:len(data)-1]
+		}
+
+		// consecutive record separators do not denote empty texts
+		if len(bytes.TrimSpace(data)) == 0 {
+			if err == io.EOF {
+				return nil, io.EOF
+			}
+			continue
+		}
+
+		return decodeSeqText(data, s.opts)
+	}
+}
+
+func decodeSeqText(data []byte, opts []UnmarshalOption) (Object, error) {
+	if !json.Valid(data) {
+		return nil, ErrTruncatedText
+	}
+
+	return Unmarshal(data, opts...)
+}
+
+// A SeqWriter writes GeoJSON text sequences as defined by RFC 8142.
+type SeqWriter struct {
+	w    io.Writer
+	opts *marshalOptions
+}
+
+// NewSeqWriter returns a new writer that writes a GeoJSON text sequence to w.
+func NewSeqWriter(w io.Writer, opts ...MarshalOption) *SeqWriter {
+	return &SeqWriter{w: w, opts: newMarshalOptions(opts)}
+}
+
+// Encode writes the feature, geometry or feature collection as the next text of the sequence.
+func (s *SeqWriter) Encode(v Object) error {
+	data, err := marshal(v, s.opts)
+	if err != nil {
+		return err
+	}
+
+	text := make([]byte, 0, len(data)+2)
+	text = append(text, recordSeparator)
+	text = append(text, data...)
+	text = append(text, '\n')
+
+	_, err = s.w.Write(text)
+	return err
+}
@@ -0,0 +1,93 @@
+package geojson
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestSeqReader(t *testing.T) {
+	rawSeq := "\x1e{\"type\":\"Point\",\"coordinates\":[1,2]}\n" +
+		"\x1e\x1e{\"type\":\"Feature\",\"geometry\":null,\"properties\":{\"prop0\":\"value0\"}}\n" +
+		"\x1e{\"type\":\"Feature\",\"geometry\":{\"type\":\"Point\",\"coo\n" +
+		"\x1e{\"type\":\"FeatureCollection\",\"features\":[]}\n"
+
+	r := NewSeqReader(strings.NewReader(rawSeq))
+
+	v, err := r.Next()
+	if err != nil {
+		t.Fatalf("should read geometry without issue, err %v", err)
+	}
+
+	if g, ok := v.(*Geometry); !ok || !g.IsPoint() {
+		t.Errorf("should read point geometry, got %v", v)
+	}
+
+	v, err = r.Next()
+	if err != nil {
+		t.Fatalf("should read feature without issue, err %v", err)
+	}
+
+	if f, ok := v.(*Feature); !ok || f.PropertyMustString("prop0") != "value0" {
+		t.Errorf("should read feature, got %v", v)
+	}
+
+	_, err = r.Next()
+	if !errors.Is(err, ErrTruncatedText) {
+		t.Errorf("should return truncated text error, got %v", err)
+	}
+
+	v, err = r.Next()
+	if err != nil {
+		t.Fatalf("should continue after truncated text, err %v", err)
+	}
+
+	if _, ok := v.(*FeatureCollection); !ok {
+		t.Errorf("should read feature collection, got %v", v)
+	}
+
+	if _, err = r.Next(); err != io.EOF {
+		t.Errorf("should return io.EOF at the end, got %v", err)
+	}
+}
+
+func TestSeqReaderMissingSeparator(t *testing.T) {
+	r := NewSeqReader(strings.NewReader(`{"type":"Point","coordinates":[1,2]}`))
+
+	if _, err := r.Next(); !errors.Is(err, ErrTruncatedText) {
+		t.Errorf("should return error if no record separator, got %v", err)
+	}
+
+	r = NewSeqReader(strings.NewReader(""))
+	if _, err := r.Next(); err != io.EOF {
+		t.Errorf("should return io.EOF for empty input, got %v", err)
+	}
+}
+
+func TestSeqWriter(t *testing.T) {
+	buf := &bytes.Buffer{}
+	w := NewSeqWriter(buf)
+
+	if err := w.Encode(NewPointGeometry([]float64{1, 2})); err != nil {
+		t.Fatalf("should encode without issue, err %v", err)
+	}
+
+	if err := w.Encode(NewPointFeature([]float64{3, 4})); err != nil {
+		t.Fatalf("should encode without issue, err %v", err)
+	}
+
+	expected := "\x1e{\"type\":\"Point\",\"coordinates\":[1,2]}\n" +
+		"\x1e{\"type\":\"Feature\",\"geometry\":{\"type\":\"Point\",\"coordinates\":[3,4]},\"properties\":{}}\n"
+	if buf.String() != expected {
+		t.Errorf("incorrect sequence, got %q", buf.String())
+	}
+
+	r := NewSeqReader(buf)
+	for i := 0; i < 2; i++ {
+		if _, err := r.Next(); err != nil {
+			t.Errorf("should read back written text, err %v", err)
+		}
+	}
+}
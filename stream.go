@@ -0,0 +1,383 @@
+package geojson
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"strconv"
+)
+
+const (
+	streamStart = iota
+	streamMembers
+	streamFeatures
+)
+
+// A FeatureCollectionDecoder reads a GeoJSON feature collection from an input stream
+// one feature at a time. Only the feature currently being decoded is held in memory,
+// which allows for the processing of collections larger than the available memory.
+type FeatureCollectionDecoder struct {
+	dec   *json.Decoder
+	opts  *unmarshalOptions
+	state int
+	err   error
+
+	index       int
+	typ         string
+	boundingBox []float64
+	crs         map[string]interface{}
+	dimension   int
+
+	foreignMembers map[string]interface{}
+}
+
+// NewFeatureCollectionDecoder returns a new decoder that reads a feature collection from r.
+func NewFeatureCollectionDecoder(r io.Reader, opts ...UnmarshalOption) *FeatureCollectionDecoder {
+	return &FeatureCollectionDecoder{
+		dec:  json.NewDecoder(r),
+		opts: newUnmarshalOptions(opts),
+	}
+}
+
+// Next decodes and returns the next feature of the collection.
+// It returns io.EOF once the end of the feature collection object has been reached.
+// A null entry in the features array is returned as a nil feature.
+func (d *FeatureCollectionDecoder) Next() (*Feature, error) {
+	if d.err != nil {
+		return nil, d.err
+	}
+
+	f, err := d.next()
+	if err != nil {
+		d.err = err
+	}
+
+	return f, err
+}
+
+// BoundingBox returns the bbox member of the feature collection.
+// Members that appear after the features array are only available once Next has returned io.EOF.
+func (d *FeatureCollectionDecoder) BoundingBox() []float64 {
+	return d.boundingBox
+}
+
+// CRS returns the crs member of the feature collection.
+// Members that appear after the features array are only available once Next has returned io.EOF.
+func (d *FeatureCollectionDecoder) CRS() map[string]interface{} {
+	return d.crs
+}
+
+// ForeignMembers returns the members of the feature collection not defined by the GeoJSON spec.
+// Members that appear after the features array are only available once Next has returned io.EOF.
+func (d *FeatureCollectionDecoder) ForeignMembers() map[string]interface{} {
+	return d.foreignMembers
+}
+
+func (d *FeatureCollectionDecoder) next() (*Feature, error) {
+	raw, err := d.nextMember()
+	if err != nil || raw == nil {
+		return nil, err
+	}
+
+	f := &Feature{}
+	if err := decodeFeature(f, raw, d.opts); err != nil {
+		return nil, d.memberError(err)
+	}
+	d.trackDimension(f.Geometry)
+
+	return f, nil
+}
+
+// nextMember reads the collection up to the next entry of the features array
+// and returns its raw JSON, or nil for a null entry. The caller decodes the entry,
+// using memberError for the errors and trackDimension for the geometry, which
+// allows the same reading of the collection for any type of feature.
+func (d *FeatureCollectionDecoder) nextMember() (json.RawMessage, error) {
+	if d.state == streamStart {
+		t, err := d.token()
+		if err != nil {
+			return nil, err
+		}
+
+		if t != json.Delim('{') {
+			return nil, &DecodeError{Expected: "feature collection object", Actual: jsonType(t)}
+		}
+		d.state = streamMembers
+	}
+
+	for {
+		if d.state == streamFeatures {
+			if d.dec.More() {
+				raw, err := d.decodeMember()
+				d.index++
+				if err != nil {
+					return nil, d.memberError(err)
+				}
+
+				return raw, nil
+			}
+
+			// the closing bracket of the features array
+			if _, err := d.token(); err != nil {
+				return nil, err
+			}
+			d.state = streamMembers
+		}
+
+		t, err := d.token()
+		if err != nil {
+			return nil, err
+		}
+
+		if t == json.Delim('}') {
+			if d.opts.strict {
+				if err := d.checkStrict(); err != nil {
+					return nil, err
+				}
+			}
+
+			return nil, io.EOF
+		}
+
+		key, _ := t.(string)
+		switch key {
+		case "type":
+			var t interface{}
+			if err := d.decode(&t); err != nil {
+				return nil, err
+			}
+
+			s, ok := t.(string)
+			if !ok {
+				return nil, &DecodeError{Path: "type", Expected: "string", Actual: jsonType(t)}
+			}
+			d.typ = s
+		case "bbox":
+			var bb interface{}
+			if err := d.decode(&bb); err != nil {
+				return nil, err
+			}
+
+			d.boundingBox, err = decodeBoundingBox(bb)
+			if err != nil {
+				return nil, prefixPath(err, "bbox")
+			}
+		case "crs":
+			var crs interface{}
+			if err := d.decode(&crs); err != nil {
+				return nil, err
+			}
+
+			m, ok := crs.(map[string]interface{})
+			if !ok && crs != nil {
+				return nil, &DecodeError{Path: "crs", Expected: "object", Actual: jsonType(crs)}
+			}
+			d.crs = m
+		case "features":
+			t, err := d.token()
+			if err != nil {
+				return nil, err
+			}
+
+			if t == json.Delim('[') {
+				d.state = streamFeatures
+			} else if t != nil {
+				return nil, &DecodeError{Path: "features", Expected: "array of features", Actual: jsonType(t)}
+			}
+		default:
+			var raw json.RawMessage
+			if err := d.decode(&raw); err != nil {
+				return nil, err
+			}
+
+			var v interface{}
+			if err := d.opts.unmarshalValue(raw, &v); err != nil {
+				return nil, err
+			}
+
+			if d.foreignMembers == nil {
+				d.foreignMembers = make(map[string]interface{})
+			}
+			d.foreignMembers[key] = v
+		}
+	}
+}
+
+func (d *FeatureCollectionDecoder) decodeMember() (json.RawMessage, error) {
+	var raw json.RawMessage
+	if err := d.decode(&raw); err != nil {
+		return nil, err
+	}
+
+	if isNull(raw) {
+		if d.opts.strict {
+			return nil, &DecodeError{Expected: "feature object", Actual: "null"}
+		}
+		return nil, nil
+	}
+
+	return raw, nil
+}
+
+// memberError prefixes the error with the path of the last entry returned by nextMember.
+func (d *FeatureCollectionDecoder) memberError(err error) error {
+	return prefixPath(prefixIndex(err, d.index-1), "features")
+}
+
+// trackDimension records the dimension of the geometry for the strict bbox check.
+func (d *FeatureCollectionDecoder) trackDimension(g *Geometry) {
+	if dim := geometryDimension(g); dim > d.dimension {
+		d.dimension = dim
+	}
+}
+
+// checkStrict verifies the collection level members once all the features have been read.
+func (d *FeatureCollectionDecoder) checkStrict() error {
+	if d.typ != "FeatureCollection" {
+		return &DecodeError{Path: "type", Expected: `"FeatureCollection"`, Actual: strconv.Quote(d.typ)}
+	}
+
+	return prefixPath(checkStrictBoundingBox(d.boundingBox, d.dimension), "bbox")
+}
+
+// token and decode wrap the json decoder so that reaching the end of the input
+// part way through the collection is not mistaken for the end of the features.
+func (d *FeatureCollectionDecoder) token() (json.Token, error) {
+	t, err := d.dec.Token()
+	if err == io.EOF {
+		return nil, io.ErrUnexpectedEOF
+	}
+
+	return t, err
+}
+
+func (d *FeatureCollectionDecoder) decode(v interface{}) error {
+	err := d.dec.Decode(v)
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+
+	return err
+}
+
+// A FeatureCollectionEncoder writes a GeoJSON feature collection to an output stream
+// one feature at a time. The output is identical to that of FeatureCollection.MarshalJSON
+// for the same bounding box, features and crs.
+type FeatureCollectionEncoder struct {
+	// BoundingBox is written before the first feature and must be set before calling AddFeature.
+	BoundingBox []float64
+
+	// CRS is written after the features and must be set before calling Close.
+	CRS map[string]interface{}
+
+	// ForeignMembers are written after the features and must be set before calling Close.
+	ForeignMembers map[string]interface{}
+
+	w       io.Writer
+	opts    *marshalOptions
+	started bool
+	count   int
+	err     error
+}
+
+// NewFeatureCollectionEncoder returns a new encoder that writes a feature collection to w.
+// The options are applied to the bounding box and every feature.
+func NewFeatureCollectionEncoder(w io.Writer, opts ...MarshalOption) *FeatureCollectionEncoder {
+	return &FeatureCollectionEncoder{w: w, opts: newMarshalOptions(opts)}
+}
+
+// AddFeature encodes the feature and writes it to the stream.
+// The collection header is written along with the first feature.
+func (e *FeatureCollectionEncoder) AddFeature(f *Feature) error {
+	if e.err != nil {
+		return e.err
+	}
+
+	if err := e.start(); err != nil {
+		return err
+	}
+
+	data := []byte("null")
+	if f != nil {
+		var err error
+		if data, err = marshal(f, e.opts); err != nil {
+			return err
+		}
+	}
+
+	if e.count > 0 {
+		data = append([]byte{','}, data...)
+	}
+	e.count++
+
+	return e.write(data)
+}
+
+// Close finishes the features array and the collection object.
+// It does not close the underlying writer.
+func (e *FeatureCollectionEncoder) Close() error {
+	if e.err != nil {
+		return e.err
+	}
+
+	if err := e.start(); err != nil {
+		return err
+	}
+
+	data := []byte{']'}
+	if e.CRS != nil && len(e.CRS) != 0 {
+		crs, err := json.Marshal(e.CRS)
+		if err != nil {
+			e.err = err
+			return err
+		}
+
+		data = append(data, `,"crs":`...)
+		data = append(data, crs...)
+	}
+	data = append(data, '}')
+
+	data, err := appendForeignMembers(data, e.ForeignMembers, featureCollectionMembers)
+	if err != nil {
+		e.err = err
+		return err
+	}
+
+	if err := e.write(data); err != nil {
+		return err
+	}
+
+	e.err = errors.New("feature collection encoder is closed")
+	return nil
+}
+
+func (e *FeatureCollectionEncoder) start() error {
+	if e.started {
+		return nil
+	}
+	e.started = true
+
+	data := []byte(`{"type":"FeatureCollection"`)
+	if e.BoundingBox != nil && len(e.BoundingBox) != 0 {
+		bb, err := json.Marshal(e.opts.boundingBox(e.BoundingBox))
+		if err != nil {
+			e.err = err
+			return err
+		}
+
+		data = append(data, `,"bbox":`...)
+		data = append(data, bb...)
+	}
+	data = append(data, `,"features":[`...)
+
+	return e.write(data)
+}
+
+func (e *FeatureCollectionEncoder) write(data []byte) error {
+	if _, err := e.w.Write(data); err != nil {
+		e.err = err
+		return err
+	}
+
+	return nil
+}
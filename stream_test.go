@@ -0,0 +1,196 @@
+package geojson
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestFeatureCollectionDecoder(t *testing.T) {
+	rawJSON := `
+	  { "type": "FeatureCollection",
+	    "bbox": [100, 0, 105, 1],
+	    "features": [
+	      { "type": "Feature",
+	        "geometry": {"type": "Point", "coordinates": [102.0, 0.5]},
+	        "properties": {"prop0": "value0"}
+	      },
+	      { "type": "Feature",
+	        "geometry": {
+	          "type": "LineString",
+	          "coordinates": [[102.0, 0.0], [103.0, 1.0], [104.0, 0.0], [105.0, 1.0]]
+	        },
+	        "properties": {"prop0": "value1"}
+	      }
+	    ],
+	    "crs": {"type": "name", "properties": {"name": "urn:ogc:def:crs:OGC:1.3:CRS84"}}
+	  }`
+
+	d := NewFeatureCollectionDecoder(strings.NewReader(rawJSON))
+	if len(d.BoundingBox()) != 0 {
+		t.Errorf("should not have a bounding box before reading")
+	}
+
+	var features []*Feature
+	for {
+		f, err := d.Next()
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			t.Fatalf("should decode feature without issue, err %v", err)
+		}
+
+		if len(features) == 0 && len(d.BoundingBox()) != 4 {
+			t.Errorf("should have bounding box before the features")
+		}
+
+		features = append(features, f)
+	}
+
+	if len(features) != 2 {
+		t.Fatalf("should have 2 features but got %d", len(features))
+	}
+
+	if !features[0].Geometry.IsPoint() {
+		t.Errorf("first feature should be a point, got %v", features[0].Geometry.Type)
+	}
+
+	if v := features[1].PropertyMustString("prop0"); v != "value1" {
+		t.Errorf("should decode properties, got %v", v)
+	}
+
+	if d.CRS() == nil {
+		t.Errorf("should decode crs after the features")
+	}
+
+	if _, err := d.Next(); err != io.EOF {
+		t.Errorf("should continue to return io.EOF, got %v", err)
+	}
+}
+
+func TestFeatureCollectionDecoderEmpty(t *testing.T) {
+	cases := []struct {
+		name string
+		data string
+	}{
+		{
+			name: "empty array",
+			data: `{"type": "FeatureCollection", "features": []}`,
+		},
+		{
+			name: "null features",
+			data: `{"type": "FeatureCollection", "features": null}`,
+		},
+		{
+			name: "no features",
+			data: `{"type": "FeatureCollection", "foo": {"bar": [1, 2]}}`,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			d := NewFeatureCollectionDecoder(strings.NewReader(tc.data))
+			if _, err := d.Next(); err != io.EOF {
+				t.Errorf("should return io.EOF, got %v", err)
+			}
+		})
+	}
+}
+
+func TestFeatureCollectionDecoderErrors(t *testing.T) {
+	cases := []struct {
+		name string
+		data string
+	}{
+		{
+			name: "not an object",
+			data: `[1, 2]`,
+		},
+		{
+			name: "features not an array",
+			data: `{"type": "FeatureCollection", "features": {}}`,
+		},
+		{
+			name: "invalid geometry",
+			data: `{"type": "FeatureCollection", "features": [{"type": "Feature", "geometry": {"type": "Point", "coordinates": "foo"}}]}`,
+		},
+		{
+			name: "truncated",
+			data: `{"type": "FeatureCollection", "features": [{"type": "Feature", "geometry": null}`,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			d := NewFeatureCollectionDecoder(strings.NewReader(tc.data))
+
+			var err error
+			for err == nil {
+				_, err = d.Next()
+			}
+
+			if err == io.EOF {
+				t.Errorf("should return an error")
+			}
+		})
+	}
+}
+
+func TestFeatureCollectionEncoder(t *testing.T) {
+	fc := NewFeatureCollection()
+	fc.BoundingBox = []float64{1, 2, 5, 6}
+	fc.CRS = map[string]interface{}{
+		"type":       "name",
+		"properties": map[string]interface{}{"name": "urn:ogc:def:crs:OGC:1.3:CRS84"},
+	}
+	fc.AddFeature(NewPointFeature([]float64{1, 2}))
+	fc.AddFeature(NewLineStringFeature([][]float64{{3, 4}, {5, 6}}))
+	fc.Features[1].ID = "line"
+	fc.Features[1].SetProperty("prop0", "value0")
+
+	buf := &bytes.Buffer{}
+	e := NewFeatureCollectionEncoder(buf)
+	e.BoundingBox = fc.BoundingBox
+	for _, f := range fc.Features {
+		if err := e.AddFeature(f); err != nil {
+			t.Fatalf("should add feature without issue, err %v", err)
+		}
+	}
+	e.CRS = fc.CRS
+
+	if err := e.Close(); err != nil {
+		t.Fatalf("should close without issue, err %v", err)
+	}
+
+	expected, err := fc.MarshalJSON()
+	if err != nil {
+		t.Fatalf("should marshal to json just fine but got %v", err)
+	}
+
+	if !bytes.Equal(buf.Bytes(), expected) {
+		t.Errorf("output not equal to MarshalJSON")
+		t.Logf("%v", buf.String())
+		t.Logf("%v", string(expected))
+	}
+
+	if err := e.AddFeature(NewPointFeature([]float64{1, 2})); err == nil {
+		t.Errorf("should return error when adding feature after close")
+	}
+}
+
+func TestFeatureCollectionEncoderEmpty(t *testing.T) {
+	buf := &bytes.Buffer{}
+	e := NewFeatureCollectionEncoder(buf)
+	if err := e.Close(); err != nil {
+		t.Fatalf("should close without issue, err %v", err)
+	}
+
+	expected, _ := NewFeatureCollection().MarshalJSON()
+	if !bytes.Equal(buf.Bytes(), expected) {
+		t.Errorf("output not equal to MarshalJSON")
+		t.Logf("%v", buf.String())
+	}
+}
@@ -0,0 +1,165 @@
+package geojson
+
+import (
+	"strconv"
+)
+
+// checkStrictFeature verifies the decoded feature follows RFC 7946.
+func checkStrictFeature(f *Feature, o *unmarshalOptions) error {
+	if f.Type != "Feature" {
+		return &DecodeError{Path: "type", Expected: `"Feature"`, Actual: strconv.Quote(f.Type)}
+	}
+
+	if f.Geometry != nil {
+		if err := checkStrictGeometry(f.Geometry, o); err != nil {
+			return prefixPath(err, "geometry")
+		}
+	}
+
+	return prefixPath(checkStrictBoundingBox(f.BoundingBox, geometryDimension(f.Geometry)), "bbox")
+}
+
+// checkStrictGeometry verifies the decoded geometry follows RFC 7946.
+// An empty point or line string is rejected as it has too few numbers or positions,
+// unless the AllowEmptyGeometries option is set. Empty multi geometries, polygons
+// and collections have no such minimum.
+func checkStrictGeometry(g *Geometry, o *unmarshalOptions) error {
+	var err error
+
+	switch g.Type {
+	case GeometryPoint:
+		if len(g.Point) != 0 || !o.allowEmpty {
+			err = checkStrictPosition(g.Point)
+		}
+	case GeometryMultiPoint:
+		for i, p := range g.MultiPoint {
+			if err = checkStrictPosition(p); err != nil {
+				err = prefixIndex(err, i)
+				break
+			}
+		}
+	case GeometryLineString:
+		if len(g.LineString) != 0 || !o.allowEmpty {
+			err = checkStrictLineString(g.LineString)
+		}
+	case GeometryMultiLineString:
+		for i, ls := range g.MultiLineString {
+			if err = checkStrictLineString(ls); err != nil {
+				err = prefixIndex(err, i)
+				break
+			}
+		}
+	case GeometryPolygon:
+		err = checkStrictPolygon(g.Polygon)
+	case GeometryMultiPolygon:
+		for i, p := range g.MultiPolygon {
+			if err = checkStrictPolygon(p); err != nil {
+				err = prefixIndex(err, i)
+				break
+			}
+		}
+	case GeometryCollection:
+		for i, c := range g.Geometries {
+			if err := checkStrictGeometry(c, o); err != nil {
+				return prefixPath(prefixIndex(err, i), "geometries")
+			}
+		}
+	default:
+		return &DecodeError{Path: "type", Expected: "geometry type", Actual: strconv.Quote(string(g.Type))}
+	}
+
+	if err != nil {
+		return prefixPath(err, "coordinates")
+	}
+
+	return prefixPath(checkStrictBoundingBox(g.BoundingBox, geometryDimension(g)), "bbox")
+}
+
+func checkStrictPosition(p []float64) error {
+	if len(p) < 2 || len(p) > 3 {
+		return &DecodeError{Expected: "position of 2 or 3 numbers", Actual: count(len(p), "number")}
+	}
+
+	return nil
+}
+
+func checkStrictLineString(ls [][]float64) error {
+	if len(ls) < 2 {
+		return &DecodeError{Expected: "line string of at least 2 positions", Actual: count(len(ls), "position")}
+	}
+
+	for i, p := range ls {
+		if err := checkStrictPosition(p); err != nil {
+			return prefixIndex(err, i)
+		}
+	}
+
+	return nil
+}
+
+func checkStrictPolygon(polygon [][][]float64) error {
+	for i, ring := range polygon {
+		if len(ring) < 4 {
+			return prefixIndex(&DecodeError{Expected: "linear ring of at least 4 positions", Actual: count(len(ring), "position")}, i)
+		}
+
+		for j, p := range ring {
+			if err := checkStrictPosition(p); err != nil {
+				return prefixIndex(prefixIndex(err, j), i)
+			}
+		}
+
+		if !equalPositions(ring[0], ring[len(ring)-1]) {
+			return prefixIndex(&DecodeError{Expected: "closed linear ring", Actual: "unclosed linear ring"}, i)
+		}
+	}
+
+	return nil
+}
+
+// checkStrictBoundingBox verifies the bbox has two values for each dimension of the geometries.
+// If the dimension is unknown, e.g. the geometries are empty, a 2 or 3 dimensional bbox is allowed.
+func checkStrictBoundingBox(bb []float64, dimension int) error {
+	if len(bb) == 0 {
+		return nil
+	}
+
+	if dimension == 0 {
+		if len(bb) != 4 && len(bb) != 6 {
+			return &DecodeError{Expected: "4 or 6 numbers", Actual: count(len(bb), "number")}
+		}
+		return nil
+	}
+
+	if len(bb) != 2*dimension {
+		return &DecodeError{Expected: count(2*dimension, "number"), Actual: count(len(bb), "number")}
+	}
+
+	return nil
+}
+
+// geometryDimension returns the largest number of elements of the geometry's positions.
+func geometryDimension(g *Geometry) int {
+	dimension := 0
+	eachPosition(g, func(p []float64) {
+		if len(p) > dimension {
+			dimension = len(p)
+		}
+	})
+
+	return dimension
+}
+
+func equalPositions(a, b []float64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,164 @@
+package geojson
+
+import (
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestUnmarshalGeometryStrict(t *testing.T) {
+	cases := []struct {
+		name  string
+		data  string
+		valid bool
+	}{
+		{
+			name:  "point",
+			data:  `{"type": "Point", "coordinates": [1, 2, 3]}`,
+			valid: true,
+		},
+		{
+			name:  "empty multi-point",
+			data:  `{"type": "MultiPoint", "coordinates": []}`,
+			valid: true,
+		},
+		{
+			name: "empty point",
+			data: `{"type": "Point", "coordinates": []}`,
+		},
+		{
+			name: "empty line string",
+			data: `{"type": "LineString", "coordinates": []}`,
+		},
+		{
+			name:  "polygon",
+			data:  `{"type": "Polygon", "coordinates": [[[1, 2], [3, 4], [5, 2], [1, 2]]], "bbox": [1, 2, 5, 4]}`,
+			valid: true,
+		},
+		{
+			name: "unknown type",
+			data: `{"type": "Circle", "coordinates": [1, 2]}`,
+		},
+		{
+			name: "short position",
+			data: `{"type": "Point", "coordinates": [1]}`,
+		},
+		{
+			name: "long position",
+			data: `{"type": "MultiPoint", "coordinates": [[1, 2, 3, 4]]}`,
+		},
+		{
+			name: "single position line string",
+			data: `{"type": "LineString", "coordinates": [[1, 2]]}`,
+		},
+		{
+			name: "short ring",
+			data: `{"type": "Polygon", "coordinates": [[[1, 2], [3, 4], [1, 2]]]}`,
+		},
+		{
+			name: "unclosed ring",
+			data: `{"type": "MultiPolygon", "coordinates": [[[[1, 2], [3, 4], [5, 2], [1, 3]]]]}`,
+		},
+		{
+			name: "bbox dimension",
+			data: `{"type": "Point", "coordinates": [1, 2], "bbox": [1, 2, 0, 1, 2, 0]}`,
+		},
+		{
+			name: "collection member",
+			data: `{"type": "GeometryCollection", "geometries": [{"type": "LineString", "coordinates": [[1, 2]]}]}`,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := UnmarshalGeometry([]byte(tc.data))
+			if err != nil {
+				t.Fatalf("should unmarshal without strict mode, err %v", err)
+			}
+
+			_, err = UnmarshalGeometry([]byte(tc.data), Strict())
+			if tc.valid && err != nil {
+				t.Errorf("should unmarshal in strict mode, err %v", err)
+			}
+
+			if !tc.valid && err == nil {
+				t.Errorf("should return error in strict mode")
+			}
+		})
+	}
+}
+
+func TestUnmarshalGeometryAllowEmpty(t *testing.T) {
+	for _, data := range []string{
+		`{"type": "Point", "coordinates": []}`,
+		`{"type": "LineString", "coordinates": []}`,
+		`{"type": "GeometryCollection", "geometries": [{"type": "Point", "coordinates": []}]}`,
+	} {
+		g, err := UnmarshalGeometry([]byte(data), Strict(), AllowEmptyGeometries())
+		if err != nil {
+			t.Errorf("should allow empty geometry %s, err %v", data, err)
+			continue
+		}
+
+		if !g.IsEmpty() {
+			t.Errorf("should be empty, got %v", g)
+		}
+	}
+
+	_, err := UnmarshalGeometry([]byte(`{"type": "Point", "coordinates": [1]}`), Strict(), AllowEmptyGeometries())
+	if err == nil {
+		t.Errorf("should still reject short positions")
+	}
+
+	rawJSON := `{"type": "Feature", "geometry": {"type": "LineString", "coordinates": []}, "properties": {}}`
+	if _, err := UnmarshalFeature([]byte(rawJSON), Strict()); err == nil {
+		t.Errorf("should reject empty feature geometry")
+	}
+
+	if _, err := UnmarshalFeature([]byte(rawJSON), Strict(), AllowEmptyGeometries()); err != nil {
+		t.Errorf("should allow empty feature geometry, err %v", err)
+	}
+}
+
+func TestUnmarshalFeatureStrict(t *testing.T) {
+	rawJSON := `{"type": "Feature", "geometry": null, "properties": {}}`
+	if _, err := UnmarshalFeature([]byte(rawJSON), Strict()); err != nil {
+		t.Errorf("should unmarshal feature in strict mode, err %v", err)
+	}
+
+	rawJSON = `{"type": "feature", "geometry": null, "properties": {}}`
+	if _, err := UnmarshalFeature([]byte(rawJSON), Strict()); err == nil {
+		t.Errorf("should return error for incorrect type")
+	}
+
+	rawJSON = `{"type": "Feature", "geometry": {"type": "Point", "coordinates": [1]}, "properties": {}}`
+	if _, err := UnmarshalFeature([]byte(rawJSON), Strict()); err == nil {
+		t.Errorf("should return error for invalid geometry")
+	}
+}
+
+func TestUnmarshalFeatureCollectionStrict(t *testing.T) {
+	rawJSON := `{"type": "FeatureCollection", "bbox": [1, 2, 3, 4], "features": [
+		{"type": "Feature", "geometry": {"type": "Point", "coordinates": [1, 2]}, "properties": {}}
+	]}`
+	if _, err := UnmarshalFeatureCollection([]byte(rawJSON), Strict()); err != nil {
+		t.Errorf("should unmarshal feature collection in strict mode, err %v", err)
+	}
+
+	rawJSON = `{"type": "FeatureCollection", "bbox": [1, 2, 0, 3, 4, 0], "features": [
+		{"type": "Feature", "geometry": {"type": "Point", "coordinates": [1, 2]}, "properties": {}}
+	]}`
+	if _, err := UnmarshalFeatureCollection([]byte(rawJSON), Strict()); err == nil {
+		t.Errorf("should return error for bbox dimension")
+	}
+
+	rawJSON = `{"features": [], "type": "Collection"}`
+	if _, err := UnmarshalFeatureCollection([]byte(rawJSON), Strict()); err == nil {
+		t.Errorf("should return error for incorrect type")
+	}
+
+	d := NewFeatureCollectionDecoder(strings.NewReader(rawJSON), Strict())
+	if _, err := d.Next(); err == nil || err == io.EOF {
+		t.Errorf("decoder should return error for incorrect type, got %v", err)
+	}
+}
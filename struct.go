@@ -0,0 +1,243 @@
+package geojson
+
+import (
+	"fmt"
+	"reflect"
+)
+
+var (
+	geometryType = reflect.TypeOf(Geometry{})
+	positionType = reflect.TypeOf([]float64{})
+)
+
+// FeatureFromStruct creates a feature from the struct v, or the struct it points to.
+// The field tagged `geojson:"geometry"` is the geometry of the feature and must be
+// a *Geometry, a Geometry or a position, i.e. a []float64 or a [2]float64 or [3]float64 array,
+// which is converted to a point. The field tagged `geojson:"id"` is the id of the feature.
+// All the other fields are the properties, see SetPropertiesFrom.
+//
+//	type Store struct {
+//		ID       int        `geojson:"id"`
+//		Location [2]float64 `geojson:"geometry"`
+//		Name     string     `json:"name"`
+//	}
+func FeatureFromStruct(v interface{}) (*Feature, error) {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() == reflect.Ptr && !rv.IsNil() {
+		rv = rv.Elem()
+	}
+
+	if rv.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("feature from %T: must be a struct or a pointer to a struct", v)
+	}
+
+	geometry, id, err := geojsonFields(rv.Type())
+	if err != nil {
+		return nil, err
+	}
+
+	f := NewFeature(nil)
+	if geometry != nil {
+		f.Geometry = structGeometry(rv.FieldByIndex(geometry))
+	}
+
+	if id != nil {
+		value := rv.FieldByIndex(id)
+		if value.Kind() != reflect.Ptr || !value.IsNil() {
+			f.ID = reflect.Indirect(value).Interface()
+		}
+	}
+
+	if err := f.SetPropertiesFrom(rv.Interface()); err != nil {
+		return nil, err
+	}
+
+	return f, nil
+}
+
+// FeatureCollectionFromSlice creates a feature collection from a slice or array of structs,
+// or pointers to structs, using FeatureFromStruct. Nil pointers become null features.
+func FeatureCollectionFromSlice(v interface{}) (*FeatureCollection, error) {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() == reflect.Ptr && !rv.IsNil() {
+		rv = rv.Elem()
+	}
+
+	if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+		return nil, fmt.Errorf("feature collection from %T: must be a slice or an array", v)
+	}
+
+	fc := NewFeatureCollection()
+	for i := 0; i < rv.Len(); i++ {
+		elem := rv.Index(i)
+		if elem.Kind() == reflect.Ptr && elem.IsNil() {
+			fc.AddFeature(nil)
+			continue
+		}
+
+		f, err := FeatureFromStruct(elem.Interface())
+		if err != nil {
+			return nil, fmt.Errorf("features[%d]: %w", i, err)
+		}
+		fc.AddFeature(f)
+	}
+
+	return fc, nil
+}
+
+// DecodeStruct sets the fields of the struct pointed to by v from the feature.
+// It is the inverse of FeatureFromStruct. A position field requires a point geometry.
+// A *PropertiesError is returned if some of the properties are missing or mismatched.
+func (f *Feature) DecodeStruct(v interface{}) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("decode feature into %T: must be a non-nil pointer to a struct", v)
+	}
+	rv = rv.Elem()
+
+	geometry, id, err := geojsonFields(rv.Type())
+	if err != nil {
+		return err
+	}
+
+	if geometry != nil {
+		if err := setStructGeometry(rv.FieldByIndex(geometry), f.Geometry); err != nil {
+			return err
+		}
+	}
+
+	if id != nil && f.ID != nil {
+		if err := setField(rv.FieldByIndex(id), f.ID); err != nil {
+			return fmt.Errorf("id: %v", err)
+		}
+	}
+
+	return f.DecodeProperties(v)
+}
+
+// DecodeSlice sets the slice pointed to by v, of structs or pointers to structs,
+// from the features of the collection using Feature.DecodeStruct.
+// Null features become nil pointers or zero structs.
+func (fc *FeatureCollection) DecodeSlice(v interface{}) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() || rv.Elem().Kind() != reflect.Slice {
+		return fmt.Errorf("decode feature collection into %T: must be a non-nil pointer to a slice", v)
+	}
+
+	slice := reflect.MakeSlice(rv.Elem().Type(), len(fc.Features), len(fc.Features))
+	for i, f := range fc.Features {
+		if f == nil {
+			continue
+		}
+
+		elem := slice.Index(i)
+		if elem.Kind() == reflect.Ptr {
+			elem.Set(reflect.New(elem.Type().Elem()))
+			elem = elem.Elem()
+		}
+
+		if err := f.DecodeStruct(elem.Addr().Interface()); err != nil {
+			return fmt.Errorf("features[%d]: %w", i, err)
+		}
+	}
+
+	rv.Elem().Set(slice)
+	return nil
+}
+
+// geojsonFields returns the index of the fields tagged as the geometry and id of the feature.
+func geojsonFields(t reflect.Type) (geometry, id []int, err error) {
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+
+		tag := sf.Tag.Get("geojson")
+		if tag != "" && !sf.IsExported() {
+			return nil, nil, fmt.Errorf("field %s: geojson tag on unexported field", sf.Name)
+		}
+
+		switch tag {
+		case "":
+		case "geometry":
+			if !isGeometryField(sf.Type) {
+				return nil, nil, fmt.Errorf("geometry field %s: unsupported type %v", sf.Name, sf.Type)
+			}
+			geometry = []int{i}
+		case "id":
+			id = []int{i}
+		default:
+			return nil, nil, fmt.Errorf("field %s: unknown geojson tag %q", sf.Name, tag)
+		}
+	}
+
+	return geometry, id, nil
+}
+
+func isGeometryField(t reflect.Type) bool {
+	switch {
+	case t == geometryType, t == reflect.PtrTo(geometryType), t == positionType:
+		return true
+	case t.Kind() == reflect.Array && t.Elem().Kind() == reflect.Float64:
+		return t.Len() == 2 || t.Len() == 3
+	}
+
+	return false
+}
+
+// structGeometry returns the geometry of a geometry field, nil for a nil geometry or empty position.
+func structGeometry(field reflect.Value) *Geometry {
+	switch v := field.Interface().(type) {
+	case *Geometry:
+		return v
+	case Geometry:
+		return &v
+	case []float64:
+		if len(v) == 0 {
+			return nil
+		}
+		return NewPointGeometry(v)
+	}
+
+	// a [2]float64 or [3]float64 array
+	position := make([]float64, field.Len())
+	for i := range position {
+		position[i] = field.Index(i).Float()
+	}
+
+	return NewPointGeometry(position)
+}
+
+func setStructGeometry(field reflect.Value, g *Geometry) error {
+	switch field.Type() {
+	case reflect.PtrTo(geometryType):
+		field.Set(reflect.ValueOf(g))
+		return nil
+	case geometryType:
+		if g != nil {
+			field.Set(reflect.ValueOf(*g))
+		}
+		return nil
+	}
+
+	if g == nil {
+		return nil
+	}
+
+	if !g.IsPoint() {
+		return fmt.Errorf("geometry: expected Point, got %s", g.Type)
+	}
+
+	if field.Kind() == reflect.Slice {
+		field.Set(reflect.ValueOf(append([]float64(nil), g.Point...)))
+		return nil
+	}
+
+	if len(g.Point) != field.Len() {
+		return fmt.Errorf("geometry: expected position of %d numbers, got %d", field.Len(), len(g.Point))
+	}
+
+	for i, c := range g.Point {
+		field.Index(i).SetFloat(c)
+	}
+
+	return nil
+}
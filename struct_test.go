@@ -0,0 +1,144 @@
+package geojson
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+type testStore struct {
+	ID       int        `geojson:"id"`
+	Location [2]float64 `geojson:"geometry"`
+	Name     string     `json:"name"`
+	Open     bool       `json:"open,omitempty"`
+}
+
+type testRegion struct {
+	Code     *string   `geojson:"id"`
+	Boundary *Geometry `geojson:"geometry"`
+	Area     float64   `json:"area"`
+}
+
+func TestFeatureFromStruct(t *testing.T) {
+	f, err := FeatureFromStruct(testStore{ID: 7, Location: [2]float64{1, 2}, Name: "corner"})
+	if err != nil {
+		t.Fatalf("should create feature, got %v", err)
+	}
+
+	data, err := json.Marshal(f)
+	if err != nil {
+		t.Fatalf("should marshal to json just fine but got %v", err)
+	}
+
+	expected := `{"id":7,"type":"Feature","geometry":{"type":"Point","coordinates":[1,2]},"properties":{"name":"corner"}}`
+	if string(data) != expected {
+		t.Errorf("incorrect json, got %s", data)
+	}
+
+	f, err = FeatureFromStruct(&testRegion{Area: 2})
+	if err != nil {
+		t.Fatalf("should create feature, got %v", err)
+	}
+
+	if f.ID != nil || f.Geometry != nil || f.PropertyMustFloat64("area") != 2 {
+		t.Errorf("incorrect feature, got %v", f)
+	}
+
+	_, err = FeatureFromStruct(struct {
+		Location string `geojson:"geometry"`
+	}{})
+	if err == nil || !strings.Contains(err.Error(), "unsupported type") {
+		t.Errorf("should return error for unsupported geometry, got %v", err)
+	}
+
+	_, err = FeatureFromStruct(struct {
+		loc [2]float64 `geojson:"geometry"`
+	}{})
+	if err == nil || !strings.Contains(err.Error(), "unexported field") {
+		t.Errorf("should return error for unexported geometry, got %v", err)
+	}
+}
+
+func TestFeatureCollectionFromSlice(t *testing.T) {
+	stores := []*testStore{
+		{ID: 1, Location: [2]float64{1, 2}, Name: "a", Open: true},
+		nil,
+		{ID: 3, Location: [2]float64{5, 6}, Name: "c"},
+	}
+
+	fc, err := FeatureCollectionFromSlice(stores)
+	if err != nil {
+		t.Fatalf("should create feature collection, got %v", err)
+	}
+
+	if len(fc.Features) != 3 || fc.Features[1] != nil {
+		t.Fatalf("incorrect features, got %v", fc.Features)
+	}
+
+	if fc.Features[0].ID != 1 || !fc.Features[0].PropertyMustBool("open") {
+		t.Errorf("incorrect feature, got %v", fc.Features[0])
+	}
+
+	// round trip through json
+	data, err := json.Marshal(fc)
+	if err != nil {
+		t.Fatalf("should marshal to json just fine but got %v", err)
+	}
+
+	fc, err = UnmarshalFeatureCollection(data)
+	if err != nil {
+		t.Fatalf("should unmarshal feature collection without issue, err %v", err)
+	}
+
+	var back []*testStore
+	if err := fc.DecodeSlice(&back); err != nil {
+		t.Fatalf("should decode slice, got %v", err)
+	}
+
+	if !reflect.DeepEqual(back, stores) {
+		t.Errorf("incorrect round trip, got %v", back)
+	}
+
+	var values []testStore
+	if err := fc.DecodeSlice(&values); err != nil {
+		t.Fatalf("should decode slice, got %v", err)
+	}
+
+	if len(values) != 3 || values[2] != *stores[2] {
+		t.Errorf("incorrect values, got %v", values)
+	}
+}
+
+func TestFeatureDecodeStruct(t *testing.T) {
+	rawJSON := `{"type": "Feature", "id": "north",
+		"geometry": {"type": "Polygon", "coordinates": [[[0, 0], [1, 0], [1, 1], [0, 0]]]},
+		"properties": {"area": 0.5}}`
+
+	f, err := UnmarshalFeature([]byte(rawJSON))
+	if err != nil {
+		t.Fatalf("should unmarshal feature without issue, err %v", err)
+	}
+
+	var region testRegion
+	if err := f.DecodeStruct(&region); err != nil {
+		t.Fatalf("should decode struct, got %v", err)
+	}
+
+	if region.Code == nil || *region.Code != "north" || region.Area != 0.5 || region.Boundary != f.Geometry {
+		t.Errorf("incorrect struct, got %v", region)
+	}
+
+	var store testStore
+	err = f.DecodeStruct(&store)
+	if err == nil || err.Error() != "geometry: expected Point, got Polygon" {
+		t.Errorf("should return error for non point geometry, got %v", err)
+	}
+
+	var unexported struct {
+		id string `geojson:"id"`
+	}
+	if err := f.DecodeStruct(&unexported); err == nil {
+		t.Errorf("should return error for unexported id field")
+	}
+}
@@ -0,0 +1,230 @@
+package geojson
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// A ValidationError describes a structural problem with part of a GeoJSON object.
+type ValidationError struct {
+	// Path is the location of the problem within the object,
+	// e.g. features[3].geometry.coordinates[0][14]
+	Path string
+
+	// Problem describes what is wrong, e.g. linear ring is not closed.
+	Problem string
+}
+
+func (e *ValidationError) Error() string {
+	if e.Path == "" {
+		return e.Problem
+	}
+
+	return e.Path + ": " + e.Problem
+}
+
+// ValidationErrors is the list of all the problems found by Validate.
+type ValidationErrors []*ValidationError
+
+func (e ValidationErrors) Error() string {
+	problems := make([]string, len(e))
+	for i, err := range e {
+		problems[i] = err.Error()
+	}
+
+	return strings.Join(problems, "; ")
+}
+
+// Validate checks the structure of the geometry and returns all the problems found
+// as ValidationErrors, or nil if there are none. The checks are for unknown types,
+// positions with less than two numbers, NaN or infinite numbers, longitudes outside of
+// [-180, 180] and latitudes outside of [-90, 90], line strings with a single position,
+// linear rings with less than four positions or that are not closed, empty members
+// of multi geometries and collections, and mixed coordinate dimensions.
+// An empty geometry is valid.
+func (g *Geometry) Validate() error {
+	v := &validator{}
+	v.geometry(g, "")
+
+	return v.result()
+}
+
+// Validate checks the structure of the feature's geometry, see Geometry.Validate.
+func (f *Feature) Validate() error {
+	v := &validator{}
+	if f.Geometry != nil {
+		v.geometry(f.Geometry, "geometry")
+	}
+
+	return v.result()
+}
+
+// Validate checks the structure of all the features in the collection, see Geometry.Validate.
+// Null features are reported as a problem.
+func (fc *FeatureCollection) Validate() error {
+	v := &validator{}
+	for i, f := range fc.Features {
+		path := indexPath("features", i)
+		if f == nil {
+			v.add(path, "null feature")
+			continue
+		}
+
+		if f.Geometry != nil {
+			v.geometry(f.Geometry, joinPath(path, "geometry"))
+		}
+	}
+
+	return v.result()
+}
+
+type validator struct {
+	errs ValidationErrors
+}
+
+func (v *validator) add(path, format string, args ...interface{}) {
+	v.errs = append(v.errs, &ValidationError{Path: path, Problem: fmt.Sprintf(format, args...)})
+}
+
+func (v *validator) result() error {
+	if len(v.errs) == 0 {
+		return nil
+	}
+
+	return v.errs
+}
+
+func (v *validator) geometry(g *Geometry, path string) {
+	v.members(g, path)
+
+	if _, err := g.Dimension(); err != nil {
+		v.add(path, "%v", err)
+	}
+}
+
+// members checks the geometry without the dimension check, which is done once
+// for the outermost geometry so that mixed collections are only reported once.
+func (v *validator) members(g *Geometry, path string) {
+	coordinates := joinPath(path, "coordinates")
+
+	switch g.Type {
+	case GeometryPoint:
+		if len(g.Point) != 0 {
+			v.position(g.Point, coordinates)
+		}
+	case GeometryMultiPoint:
+		for i, p := range g.MultiPoint {
+			if len(p) == 0 {
+				v.add(indexPath(coordinates, i), "empty position")
+				continue
+			}
+			v.position(p, indexPath(coordinates, i))
+		}
+	case GeometryLineString:
+		if len(g.LineString) != 0 {
+			v.lineString(g.LineString, coordinates)
+		}
+	case GeometryMultiLineString:
+		for i, ls := range g.MultiLineString {
+			if len(ls) == 0 {
+				v.add(indexPath(coordinates, i), "empty line string")
+				continue
+			}
+			v.lineString(ls, indexPath(coordinates, i))
+		}
+	case GeometryPolygon:
+		v.polygon(g.Polygon, coordinates)
+	case GeometryMultiPolygon:
+		for i, p := range g.MultiPolygon {
+			if len(p) == 0 {
+				v.add(indexPath(coordinates, i), "empty polygon")
+				continue
+			}
+			v.polygon(p, indexPath(coordinates, i))
+		}
+	case GeometryCollection:
+		for i, c := range g.Geometries {
+			member := indexPath(joinPath(path, "geometries"), i)
+			if c == nil {
+				v.add(member, "null geometry")
+				continue
+			}
+			v.members(c, member)
+		}
+	default:
+		v.add(joinPath(path, "type"), "unknown geometry type %q", g.Type)
+	}
+}
+
+func (v *validator) position(p []float64, path string) {
+	if len(p) < 2 {
+		v.add(path, "position has %s, expected at least 2", count(len(p), "number"))
+	}
+
+	valid := true
+	for i, c := range p {
+		switch {
+		case math.IsNaN(c):
+			v.add(indexPath(path, i), "NaN")
+			valid = false
+		case math.IsInf(c, 0):
+			v.add(indexPath(path, i), "infinite number")
+			valid = false
+		}
+	}
+
+	if !valid || len(p) < 2 {
+		return
+	}
+
+	if p[0] < -180 || p[0] > 180 {
+		v.add(indexPath(path, 0), "longitude %v is outside of [-180, 180]", p[0])
+	}
+
+	if p[1] < -90 || p[1] > 90 {
+		v.add(indexPath(path, 1), "latitude %v is outside of [-90, 90]", p[1])
+	}
+}
+
+func (v *validator) lineString(ls [][]float64, path string) {
+	if len(ls) < 2 {
+		v.add(path, "line string has %s, expected at least 2", count(len(ls), "position"))
+	}
+
+	v.positions(ls, path)
+}
+
+func (v *validator) polygon(polygon [][][]float64, path string) {
+	for i, ring := range polygon {
+		ringPath := indexPath(path, i)
+		if len(ring) < 4 {
+			v.add(ringPath, "linear ring has %s, expected at least 4", count(len(ring), "position"))
+		}
+
+		if len(ring) != 0 && !equalPositions(ring[0], ring[len(ring)-1]) {
+			v.add(ringPath, "linear ring is not closed")
+		}
+
+		v.positions(ring, ringPath)
+	}
+}
+
+func (v *validator) positions(ps [][]float64, path string) {
+	for i, p := range ps {
+		v.position(p, indexPath(path, i))
+	}
+}
+
+func joinPath(path, member string) string {
+	if path == "" {
+		return member
+	}
+
+	return path + "." + member
+}
+
+func indexPath(path string, i int) string {
+	return path + "[" + strconv.Itoa(i) + "]"
+}
@@ -0,0 +1,125 @@
+package geojson
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestGeometryValidate(t *testing.T) {
+	cases := []struct {
+		name     string
+		geometry *Geometry
+		problems []string
+	}{
+		{
+			name:     "valid polygon",
+			geometry: NewPolygonGeometry([][][]float64{{{0, 0}, {1, 0}, {1, 1}, {0, 0}}}),
+		},
+		{
+			name:     "empty",
+			geometry: NewEmptyGeometry(GeometryMultiPolygon),
+		},
+		{
+			name: "polygon",
+			geometry: NewPolygonGeometry([][][]float64{
+				{{0, 0}, {1, 0}, {1, 1}, {0, 1}},
+				{{0, 0}, {1, 0}, {0, 0}},
+			}),
+			problems: []string{
+				"coordinates[0]: linear ring is not closed",
+				"coordinates[1]: linear ring has 3 positions, expected at least 4",
+			},
+		},
+		{
+			name:     "line string",
+			geometry: NewLineStringGeometry([][]float64{{1, 2}}),
+			problems: []string{"coordinates: line string has 1 position, expected at least 2"},
+		},
+		{
+			name:     "positions",
+			geometry: NewMultiPointGeometry([]float64{math.NaN(), 0}, []float64{200, -91}, []float64{1}, nil, []float64{0, math.Inf(1)}),
+			problems: []string{
+				"coordinates[0][0]: NaN",
+				"coordinates[1][0]: longitude 200 is outside of [-180, 180]",
+				"coordinates[1][1]: latitude -91 is outside of [-90, 90]",
+				"coordinates[2]: position has 1 number, expected at least 2",
+				"coordinates[3]: empty position",
+				"coordinates[4][1]: infinite number",
+				"mixed coordinate dimensions: 2 and 1",
+			},
+		},
+		{
+			name: "collection",
+			geometry: NewCollectionGeometry(
+				NewPointGeometry([]float64{1, 2}),
+				nil,
+				NewMultiLineStringGeometry([][]float64{{1, 2, 3}, {3, 4, 5}}, nil),
+				&Geometry{Type: "Circle"},
+			),
+			problems: []string{
+				"geometries[1]: null geometry",
+				"geometries[2].coordinates[1]: empty line string",
+				`geometries[3].type: unknown geometry type "Circle"`,
+				"mixed coordinate dimensions: 2 and 3",
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.geometry.Validate()
+			if len(tc.problems) == 0 {
+				if err != nil {
+					t.Errorf("should be valid, got %v", err)
+				}
+				return
+			}
+
+			var errs ValidationErrors
+			if !errors.As(err, &errs) {
+				t.Fatalf("should return validation errors, got %v", err)
+			}
+
+			var problems []string
+			for _, e := range errs {
+				problems = append(problems, e.Error())
+			}
+
+			if !reflect.DeepEqual(problems, tc.problems) {
+				t.Errorf("incorrect problems, got %q", problems)
+			}
+		})
+	}
+}
+
+func TestFeatureCollectionValidate(t *testing.T) {
+	fc := NewFeatureCollection()
+	fc.AddFeature(NewPointFeature([]float64{1, 2}))
+	fc.AddFeature(nil)
+	fc.AddFeature(NewFeature(nil))
+	fc.AddFeature(NewPolygonFeature([][][]float64{{{0, 0}, {1, 0}, {1, 1}}}))
+
+	err := fc.Validate()
+
+	var errs ValidationErrors
+	if !errors.As(err, &errs) || len(errs) != 3 {
+		t.Fatalf("should return 3 validation errors, got %v", err)
+	}
+
+	expected := "features[1]: null feature; " +
+		"features[3].geometry.coordinates[0]: linear ring has 3 positions, expected at least 4; " +
+		"features[3].geometry.coordinates[0]: linear ring is not closed"
+	if err.Error() != expected {
+		t.Errorf("incorrect error message, got %v", err)
+	}
+
+	if errs[1].Path != "features[3].geometry.coordinates[0]" {
+		t.Errorf("incorrect path, got %v", errs[1].Path)
+	}
+
+	if err := fc.Features[0].Validate(); err != nil {
+		t.Errorf("should be valid, got %v", err)
+	}
+}
@@ -0,0 +1,455 @@
+package geojson
+
+import (
+	"math"
+	"strconv"
+	"strings"
+)
+
+// The reasons a geometry is not valid according to the OGC Simple Features rules.
+const (
+	ReasonTooFewPoints       = "Too few points"
+	ReasonRingNotClosed      = "Ring is not closed"
+	ReasonInvalidCoordinate  = "Invalid Coordinate"
+	ReasonSelfIntersection   = "Self-intersection"
+	ReasonHoleOutsideShell   = "Hole lies outside shell"
+	ReasonNestedHoles        = "Nested holes"
+	ReasonOverlappingMembers = "Overlapping polygons"
+)
+
+// A ValidityError describes why a geometry is not valid according to the
+// OGC Simple Features rules, along with the offending position.
+type ValidityError struct {
+	// Reason is one of the Reason constants.
+	Reason string
+
+	// Path is the location of the invalid part, e.g. coordinates[1][0] for the
+	// exterior ring of the second polygon of a multi-polygon.
+	Path string
+
+	// Position is where the problem was found, e.g. the point of self-intersection.
+	Position []float64
+}
+
+func (e *ValidityError) Error() string {
+	reason := e.Reason
+	if e.Position != nil {
+		reason += formatPosition(e.Position)
+	}
+
+	if e.Path == "" {
+		return reason
+	}
+
+	return e.Path + ": " + reason
+}
+
+// IsValid returns true if the geometry follows the OGC Simple Features rules, see CheckValidity.
+func (g *Geometry) IsValid() bool {
+	return g.CheckValidity() == nil
+}
+
+// ValidityReason returns "Valid Geometry" or the reason the geometry is not valid followed
+// by the offending position, e.g. Self-intersection[1 1], in the style of PostGIS.
+func (g *Geometry) ValidityReason() string {
+	err := checkValidity(g)
+	if err == nil {
+		return "Valid Geometry"
+	}
+
+	reason := err.Reason
+	if err.Position != nil {
+		reason += formatPosition(err.Position)
+	}
+
+	return reason
+}
+
+// CheckValidity returns the first problem that makes the geometry invalid according to
+// the OGC Simple Features rules, or nil if it is valid. For polygons the rings must be
+// closed with at least four positions, must not intersect themselves or each other,
+// although they may touch at a point, holes must be inside the exterior ring and
+// must not be inside another hole. The polygons of a multi-polygon must not overlap.
+// All positions must be finite and line strings have at least two positions.
+// Empty geometries are valid. The returned error is a *ValidityError.
+func (g *Geometry) CheckValidity() error {
+	if err := checkValidity(g); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func checkValidity(g *Geometry) *ValidityError {
+	switch g.Type {
+	case GeometryPoint:
+		if len(g.Point) != 0 {
+			return checkFinite(g.Point, "coordinates")
+		}
+	case GeometryMultiPoint:
+		for i, p := range g.MultiPoint {
+			if err := checkFinite(p, indexPath("coordinates", i)); err != nil {
+				return err
+			}
+		}
+	case GeometryLineString:
+		if len(g.LineString) != 0 {
+			return checkLine(g.LineString, "coordinates")
+		}
+	case GeometryMultiLineString:
+		for i, ls := range g.MultiLineString {
+			if err := checkLine(ls, indexPath("coordinates", i)); err != nil {
+				return err
+			}
+		}
+	case GeometryPolygon:
+		return checkPolygon(g.Polygon, "coordinates")
+	case GeometryMultiPolygon:
+		for i, p := range g.MultiPolygon {
+			if err := checkPolygon(p, indexPath("coordinates", i)); err != nil {
+				return err
+			}
+		}
+		return checkOverlaps(g.MultiPolygon)
+	case GeometryCollection:
+		for i, c := range g.Geometries {
+			if c == nil {
+				continue
+			}
+
+			if err := checkValidity(c); err != nil {
+				err.Path = joinPath(indexPath("geometries", i), err.Path)
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func checkFinite(p []float64, path string) *ValidityError {
+	if len(p) < 2 {
+		return &ValidityError{Reason: ReasonInvalidCoordinate, Path: path, Position: p}
+	}
+
+	for _, c := range p {
+		if math.IsNaN(c) || math.IsInf(c, 0) {
+			return &ValidityError{Reason: ReasonInvalidCoordinate, Path: path, Position: p}
+		}
+	}
+
+	return nil
+}
+
+func checkLine(ls [][]float64, path string) *ValidityError {
+	for i, p := range ls {
+		if err := checkFinite(p, indexPath(path, i)); err != nil {
+			return err
+		}
+	}
+
+	if len(ls) < 2 {
+		var p []float64
+		if len(ls) != 0 {
+			p = ls[0]
+		}
+		return &ValidityError{Reason: ReasonTooFewPoints, Path: path, Position: p}
+	}
+
+	return nil
+}
+
+func checkPolygon(polygon [][][]float64, path string) *ValidityError {
+	for i, ring := range polygon {
+		ringPath := indexPath(path, i)
+		for j, p := range ring {
+			if err := checkFinite(p, indexPath(ringPath, j)); err != nil {
+				return err
+			}
+		}
+
+		if len(ring) < 4 {
+			var p []float64
+			if len(ring) != 0 {
+				p = ring[0]
+			}
+			return &ValidityError{Reason: ReasonTooFewPoints, Path: ringPath, Position: p}
+		}
+
+		if !equalPositions(ring[0], ring[len(ring)-1]) {
+			return &ValidityError{Reason: ReasonRingNotClosed, Path: ringPath, Position: ring[0]}
+		}
+
+		if p := ringSelfIntersection(ring); p != nil {
+			return &ValidityError{Reason: ReasonSelfIntersection, Path: ringPath, Position: p}
+		}
+	}
+
+	// the rings may touch at a point but not cross or share an edge
+	for i := range polygon {
+		for j := i + 1; j < len(polygon); j++ {
+			if p := ringsCross(polygon[i], polygon[j]); p != nil {
+				return &ValidityError{Reason: ReasonSelfIntersection, Path: indexPath(path, j), Position: p}
+			}
+		}
+	}
+
+	for i := 1; i < len(polygon); i++ {
+		p, location := ringLocation(polygon[i], polygon[0])
+		if location < 0 {
+			return &ValidityError{Reason: ReasonHoleOutsideShell, Path: indexPath(path, i), Position: p}
+		}
+
+		for j := 1; j < len(polygon); j++ {
+			if j == i {
+				continue
+			}
+
+			if p, location := ringLocation(polygon[i], polygon[j]); location > 0 {
+				return &ValidityError{Reason: ReasonNestedHoles, Path: indexPath(path, i), Position: p}
+			}
+		}
+	}
+
+	return nil
+}
+
+// checkOverlaps verifies the interiors of the valid polygons of a multi-polygon do not intersect.
+func checkOverlaps(polygons [][][][]float64) *ValidityError {
+	for i, a := range polygons {
+		if len(a) == 0 {
+			continue
+		}
+
+		for j := i + 1; j < len(polygons); j++ {
+			b := polygons[j]
+			if len(b) == 0 {
+				continue
+			}
+
+			for _, ra := range a {
+				for _, rb := range b {
+					if p := ringsCross(ra, rb); p != nil {
+						return &ValidityError{Reason: ReasonOverlappingMembers, Path: indexPath("coordinates", j), Position: p}
+					}
+				}
+			}
+
+			if p, ok := insidePolygon(b[0], a); ok {
+				return &ValidityError{Reason: ReasonOverlappingMembers, Path: indexPath("coordinates", j), Position: p}
+			}
+
+			if p, ok := insidePolygon(a[0], b); ok {
+				return &ValidityError{Reason: ReasonOverlappingMembers, Path: indexPath("coordinates", i), Position: p}
+			}
+		}
+	}
+
+	return nil
+}
+
+// insidePolygon returns a position of the ring that is in the interior of the polygon,
+// i.e. inside the exterior ring and not inside or on a hole.
+func insidePolygon(ring [][]float64, polygon [][][]float64) ([]float64, bool) {
+	p, location := ringLocation(ring, polygon[0])
+	if location <= 0 {
+		return nil, false
+	}
+
+	for _, hole := range polygon[1:] {
+		if pointInRing(p, hole) >= 0 {
+			return nil, false
+		}
+	}
+
+	return p, true
+}
+
+// ringSelfIntersection returns the first position where two segments of the ring intersect,
+// other than at the position shared by consecutive segments, or nil if there is none.
+// Repeated consecutive positions are valid and ignored.
+func ringSelfIntersection(ring [][]float64) []float64 {
+	ring = removeRepeated(ring)
+
+	segments := len(ring) - 1
+	for i := 0; i < segments; i++ {
+		a, b := ring[i], ring[i+1]
+		for j := i + 1; j < segments; j++ {
+			c, d := ring[j], ring[j+1]
+
+			adjacent := j == i+1 || (i == 0 && j == segments-1)
+			p, overlap := segmentIntersection(a, b, c, d)
+			if p == nil {
+				continue
+			}
+
+			// consecutive segments share a position, they are only invalid if they overlap
+			if adjacent && !overlap {
+				continue
+			}
+
+			return p
+		}
+	}
+
+	return nil
+}
+
+// removeRepeated returns the path without consecutive positions that are the same in 2D.
+func removeRepeated(path [][]float64) [][]float64 {
+	result := make([][]float64, 0, len(path))
+	for _, p := range path {
+		if len(result) != 0 && equal2D(result[len(result)-1], p) {
+			continue
+		}
+		result = append(result, p)
+	}
+
+	return result
+}
+
+// ringsCross returns a position where the two rings cross or share an edge, or nil
+// if they do not intersect or only touch at points.
+func ringsCross(r1, r2 [][]float64) []float64 {
+	for i := 0; i+1 < len(r1); i++ {
+		a, b := r1[i], r1[i+1]
+		for j := 0; j+1 < len(r2); j++ {
+			c, d := r2[j], r2[j+1]
+
+			p, overlap := segmentIntersection(a, b, c, d)
+			if p == nil {
+				continue
+			}
+
+			if overlap {
+				return p
+			}
+
+			// a proper crossing is in the interior of both segments
+			if !equal2D(p, a) && !equal2D(p, b) && !equal2D(p, c) && !equal2D(p, d) {
+				return p
+			}
+		}
+	}
+
+	return nil
+}
+
+// ringLocation returns the first position of the ring that is not on the boundary of the
+// other ring and whether it is inside, 1, or outside, -1, the other ring.
+// Zero is returned if all the positions are on the boundary.
+func ringLocation(ring, other [][]float64) ([]float64, int) {
+	for _, p := range ring {
+		if location := pointInRing(p, other); location != 0 {
+			return p, location
+		}
+	}
+
+	return nil, 0
+}
+
+// pointInRing returns 1 if the position is inside the ring, -1 if it is outside
+// and 0 if it is on the boundary.
+func pointInRing(p []float64, ring [][]float64) int {
+	inside := false
+	for i := 0; i+1 < len(ring); i++ {
+		a, b := ring[i], ring[i+1]
+		if onSegment(p, a, b) {
+			return 0
+		}
+
+		if (a[1] > p[1]) != (b[1] > p[1]) && p[0] < (b[0]-a[0])*(p[1]-a[1])/(b[1]-a[1])+a[0] {
+			inside = !inside
+		}
+	}
+
+	if inside {
+		return 1
+	}
+
+	return -1
+}
+
+// segmentIntersection returns a position where the segments ab and cd intersect, or nil.
+// Overlap is true if the segments are collinear and share more than a single position.
+func segmentIntersection(a, b, c, d []float64) ([]float64, bool) {
+	d1 := orientation(c, d, a)
+	d2 := orientation(c, d, b)
+	d3 := orientation(a, b, c)
+	d4 := orientation(a, b, d)
+
+	if d1 == 0 && d2 == 0 && d3 == 0 && d4 == 0 {
+		// collinear, find the positions of each segment within the other
+		var shared [][]float64
+		for _, p := range [][]float64{a, b} {
+			if onSegment(p, c, d) {
+				shared = appendPosition(shared, p[:2])
+			}
+		}
+		for _, p := range [][]float64{c, d} {
+			if onSegment(p, a, b) {
+				shared = appendPosition(shared, p[:2])
+			}
+		}
+
+		switch {
+		case len(shared) == 0:
+			return nil, false
+		case len(shared) == 1:
+			return shared[0], false
+		}
+
+		for _, p := range shared[1:] {
+			if !equal2D(p, shared[0]) {
+				return shared[0], true
+			}
+		}
+
+		return shared[0], false
+	}
+
+	if ((d1 > 0 && d2 < 0) || (d1 < 0 && d2 > 0)) && ((d3 > 0 && d4 < 0) || (d3 < 0 && d4 > 0)) {
+		t := d1 / (d1 - d2)
+		return []float64{a[0] + t*(b[0]-a[0]), a[1] + t*(b[1]-a[1])}, false
+	}
+
+	// touching at an end position
+	for _, p := range [][]float64{a, b} {
+		if onSegment(p, c, d) {
+			return p[:2], false
+		}
+	}
+
+	for _, p := range [][]float64{c, d} {
+		if onSegment(p, a, b) {
+			return p[:2], false
+		}
+	}
+
+	return nil, false
+}
+
+// orientation returns the cross product of ab and ac, positive if c is to the left of ab.
+func orientation(a, b, c []float64) float64 {
+	return (b[0]-a[0])*(c[1]-a[1]) - (b[1]-a[1])*(c[0]-a[0])
+}
+
+func onSegment(p, a, b []float64) bool {
+	return orientation(a, b, p) == 0 &&
+		math.Min(a[0], b[0]) <= p[0] && p[0] <= math.Max(a[0], b[0]) &&
+		math.Min(a[1], b[1]) <= p[1] && p[1] <= math.Max(a[1], b[1])
+}
+
+func equal2D(a, b []float64) bool {
+	return a[0] == b[0] && a[1] == b[1]
+}
+
+// formatPosition writes the position the way PostGIS does, e.g. [1 2].
+func formatPosition(p []float64) string {
+	s := make([]string, len(p))
+	for i, c := range p {
+		s[i] = strconv.FormatFloat(c, 'f', -1, 64)
+	}
+
+	return "[" + strings.Join(s, " ") + "]"
+}
@@ -0,0 +1,201 @@
+package geojson
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestGeometryValidity(t *testing.T) {
+	square := [][]float64{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}
+	hole := [][]float64{{2, 2}, {2, 4}, {4, 4}, {4, 2}, {2, 2}}
+
+	cases := []struct {
+		name     string
+		geometry *Geometry
+		reason   string
+		path     string
+	}{
+		{
+			name:     "polygon with hole",
+			geometry: NewPolygonGeometry([][][]float64{square, hole}),
+			reason:   "Valid Geometry",
+		},
+		{
+			name: "hole touching shell",
+			geometry: NewPolygonGeometry([][][]float64{square,
+				{{0, 5}, {3, 6}, {3, 4}, {0, 5}},
+			}),
+			reason: "Valid Geometry",
+		},
+		{
+			name: "repeated positions",
+			geometry: NewPolygonGeometry([][][]float64{
+				{{0, 0}, {10, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}, {0, 0}},
+				{{2, 2}, {2, 2}, {2, 4}, {4, 4}, {4, 4}, {4, 2}, {2, 2}},
+			}),
+			reason: "Valid Geometry",
+		},
+		{
+			name:     "bow tie",
+			geometry: NewPolygonGeometry([][][]float64{{{0, 0}, {2, 2}, {2, 0}, {0, 2}, {0, 0}}}),
+			reason:   "Self-intersection[1 1]",
+			path:     "coordinates[0]",
+		},
+		{
+			name:     "self touching ring",
+			geometry: NewPolygonGeometry([][][]float64{{{0, 0}, {4, 0}, {2, 2}, {4, 4}, {0, 4}, {2, 2}, {0, 0}}}),
+			reason:   "Self-intersection[2 2]",
+			path:     "coordinates[0]",
+		},
+		{
+			name:     "spike",
+			geometry: NewPolygonGeometry([][][]float64{{{0, 0}, {4, 0}, {6, 0}, {4, 0}, {4, 4}, {0, 0}}}),
+			reason:   "Self-intersection[4 0]",
+			path:     "coordinates[0]",
+		},
+		{
+			name: "hole outside shell",
+			geometry: NewPolygonGeometry([][][]float64{square,
+				{{20, 20}, {20, 22}, {22, 22}, {20, 20}},
+			}),
+			reason: "Hole lies outside shell[20 20]",
+			path:   "coordinates[1]",
+		},
+		{
+			name: "hole crossing shell",
+			geometry: NewPolygonGeometry([][][]float64{square,
+				{{5, 5}, {5, 15}, {6, 15}, {5, 5}},
+			}),
+			reason: "Self-intersection[5 10]",
+			path:   "coordinates[1]",
+		},
+		{
+			name: "nested holes",
+			geometry: NewPolygonGeometry([][][]float64{square,
+				{{1, 1}, {1, 9}, {9, 9}, {9, 1}, {1, 1}},
+				hole,
+			}),
+			reason: "Nested holes[2 2]",
+			path:   "coordinates[2]",
+		},
+		{
+			name:     "unclosed ring",
+			geometry: NewPolygonGeometry([][][]float64{{{0, 0}, {1, 0}, {1, 1}, {0, 1}}}),
+			reason:   "Ring is not closed[0 0]",
+			path:     "coordinates[0]",
+		},
+		{
+			name:     "too few points",
+			geometry: NewPolygonGeometry([][][]float64{{{0, 0}, {1, 0}, {0, 0}}}),
+			reason:   "Too few points[0 0]",
+			path:     "coordinates[0]",
+		},
+		{
+			name:     "invalid coordinate",
+			geometry: NewLineStringGeometry([][]float64{{0, 0}, {math.NaN(), 1}}),
+			reason:   "Invalid Coordinate[NaN 1]",
+			path:     "coordinates[1]",
+		},
+		{
+			name: "touching multi-polygon",
+			geometry: NewMultiPolygonGeometry(
+				[][][]float64{square},
+				[][][]float64{{{10, 10}, {20, 10}, {20, 20}, {10, 10}}},
+			),
+			reason: "Valid Geometry",
+		},
+		{
+			name: "polygon in hole",
+			geometry: NewMultiPolygonGeometry(
+				[][][]float64{square, hole},
+				[][][]float64{{{2.5, 2.5}, {3.5, 2.5}, {3.5, 3.5}, {2.5, 2.5}}},
+			),
+			reason: "Valid Geometry",
+		},
+		{
+			name: "overlapping multi-polygon",
+			geometry: NewMultiPolygonGeometry(
+				[][][]float64{square},
+				[][][]float64{{{5, 5}, {15, 5}, {15, 15}, {5, 5}}},
+			),
+			reason: "Overlapping polygons[10 5]",
+			path:   "coordinates[1]",
+		},
+		{
+			name: "contained multi-polygon",
+			geometry: NewMultiPolygonGeometry(
+				[][][]float64{{{2.5, 2.5}, {3.5, 2.5}, {3.5, 3.5}, {2.5, 2.5}}},
+				[][][]float64{square},
+			),
+			reason: "Overlapping polygons[2.5 2.5]",
+			path:   "coordinates[0]",
+		},
+		{
+			name: "shared edge multi-polygon",
+			geometry: NewMultiPolygonGeometry(
+				[][][]float64{square},
+				[][][]float64{{{10, 0}, {20, 0}, {20, 10}, {10, 10}, {10, 0}}},
+			),
+			reason: "Overlapping polygons[10 0]",
+			path:   "coordinates[1]",
+		},
+		{
+			name: "collection",
+			geometry: NewCollectionGeometry(
+				NewPointGeometry([]float64{1, 2}),
+				NewPolygonGeometry([][][]float64{{{0, 0}, {2, 2}, {2, 0}, {0, 2}, {0, 0}}}),
+			),
+			reason: "Self-intersection[1 1]",
+			path:   "geometries[1].coordinates[0]",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if r := tc.geometry.ValidityReason(); r != tc.reason {
+				t.Errorf("incorrect reason, got %v", r)
+			}
+
+			valid := tc.reason == "Valid Geometry"
+			if tc.geometry.IsValid() != valid {
+				t.Errorf("incorrect validity")
+			}
+
+			err := tc.geometry.CheckValidity()
+			if valid {
+				if err != nil {
+					t.Errorf("should not return error, got %v", err)
+				}
+				return
+			}
+
+			var ve *ValidityError
+			if !errors.As(err, &ve) {
+				t.Fatalf("should return validity error, got %v", err)
+			}
+
+			if ve.Path != tc.path {
+				t.Errorf("incorrect path, got %v", ve.Path)
+			}
+		})
+	}
+}
+
+func TestValidityErrorPosition(t *testing.T) {
+	g := NewPolygonGeometry([][][]float64{{{0, 0}, {2, 2}, {2, 0}, {0, 2}, {0, 0}}})
+
+	var ve *ValidityError
+	if !errors.As(g.CheckValidity(), &ve) {
+		t.Fatalf("should return validity error")
+	}
+
+	if ve.Reason != ReasonSelfIntersection || !reflect.DeepEqual(ve.Position, []float64{1, 1}) {
+		t.Errorf("incorrect error, got %v", ve)
+	}
+
+	if ve.Error() != "coordinates[0]: Self-intersection[1 1]" {
+		t.Errorf("incorrect error message, got %v", ve)
+	}
+}
@@ -0,0 +1,85 @@
+package geojson
+
+// A Winding is the orientation convention for the rings of polygons.
+type Winding int
+
+// The supported winding conventions.
+const (
+	// WindingRFC7946 is the right-hand rule of RFC 7946, exterior rings are
+	// counterclockwise and holes are clockwise.
+	WindingRFC7946 Winding = iota + 1
+
+	// WindingClockwise is the legacy convention used by shapefiles among others,
+	// exterior rings are clockwise and holes are counterclockwise.
+	WindingClockwise
+)
+
+// SignedArea returns the planar area of the closed ring, using the first two elements
+// of each position. The area is positive if the ring is counterclockwise
+// and negative if it is clockwise.
+func SignedArea(ring [][]float64) float64 {
+	area := 0.0
+	for i := 0; i+1 < len(ring); i++ {
+		a, b := ring[i], ring[i+1]
+		if len(a) < 2 || len(b) < 2 {
+			continue
+		}
+
+		area += a[0]*b[1] - b[0]*a[1]
+	}
+
+	return area / 2
+}
+
+// IsCounterClockwise returns true if the ring has a positive signed area.
+func IsCounterClockwise(ring [][]float64) bool {
+	return SignedArea(ring) > 0
+}
+
+// IsClockwise returns true if the ring has a negative signed area.
+func IsClockwise(ring [][]float64) bool {
+	return SignedArea(ring) < 0
+}
+
+// Rewind reverses the rings of polygons and multi-polygons, including those
+// in geometry collections, that do not follow the winding convention.
+// Rings without an area are left unchanged, as is everything for an unknown winding.
+func (g *Geometry) Rewind(w Winding) {
+	if g == nil {
+		return
+	}
+
+	switch g.Type {
+	case GeometryPolygon:
+		rewindPolygon(g.Polygon, w)
+	case GeometryMultiPolygon:
+		for _, p := range g.MultiPolygon {
+			rewindPolygon(p, w)
+		}
+	case GeometryCollection:
+		for _, c := range g.Geometries {
+			c.Rewind(w)
+		}
+	}
+}
+
+// rewindPolygon replaces the rings with reversed copies where needed, so that
+// slices shared with other polygons are not modified.
+func rewindPolygon(polygon [][][]float64, w Winding) {
+	if w != WindingRFC7946 && w != WindingClockwise {
+		return
+	}
+
+	for i, ring := range polygon {
+		exterior := i == 0
+		ccw := exterior == (w == WindingRFC7946)
+
+		if area := SignedArea(ring); (ccw && area < 0) || (!ccw && area > 0) {
+			reversed := make([][]float64, len(ring))
+			for j, p := range ring {
+				reversed[len(ring)-1-j] = p
+			}
+			polygon[i] = reversed
+		}
+	}
+}
@@ -0,0 +1,78 @@
+package geojson
+
+import (
+	"reflect"
+	"testing"
+)
+
+var (
+	testCCWRing = [][]float64{{0, 0}, {4, 0}, {4, 4}, {0, 4}, {0, 0}}
+	testCWRing  = [][]float64{{1, 1}, {1, 2}, {2, 2}, {2, 1}, {1, 1}}
+)
+
+func TestSignedArea(t *testing.T) {
+	if a := SignedArea(testCCWRing); a != 16 {
+		t.Errorf("incorrect area, got %v", a)
+	}
+
+	if a := SignedArea(testCWRing); a != -1 {
+		t.Errorf("incorrect area, got %v", a)
+	}
+
+	if !IsCounterClockwise(testCCWRing) || IsClockwise(testCCWRing) {
+		t.Errorf("should be counterclockwise")
+	}
+
+	if !IsClockwise(testCWRing) || IsCounterClockwise(testCWRing) {
+		t.Errorf("should be clockwise")
+	}
+
+	line := [][]float64{{0, 0}, {1, 1}, {0, 0}}
+	if IsClockwise(line) || IsCounterClockwise(line) {
+		t.Errorf("should have no orientation")
+	}
+}
+
+func TestGeometryRewind(t *testing.T) {
+	reverse := func(ring [][]float64) [][]float64 {
+		r := make([][]float64, len(ring))
+		for i, p := range ring {
+			r[len(ring)-1-i] = p
+		}
+		return r
+	}
+
+	legacy := [][][]float64{reverse(testCCWRing), reverse(testCWRing)}
+	g := NewCollectionGeometry(
+		NewPolygonGeometry(legacy),
+		NewMultiPolygonGeometry(legacy),
+	)
+
+	g.Rewind(WindingRFC7946)
+
+	expected := [][][]float64{testCCWRing, testCWRing}
+	if !reflect.DeepEqual(g.Geometries[0].Polygon, expected) {
+		t.Errorf("incorrect polygon, got %v", g.Geometries[0].Polygon)
+	}
+
+	if !reflect.DeepEqual(g.Geometries[1].MultiPolygon[0], expected) {
+		t.Errorf("incorrect multi-polygon, got %v", g.Geometries[1].MultiPolygon[0])
+	}
+
+	g.Rewind(WindingClockwise)
+	if !IsClockwise(g.Geometries[0].Polygon[0]) || !IsCounterClockwise(g.Geometries[0].Polygon[1]) {
+		t.Errorf("incorrect legacy winding, got %v", g.Geometries[0].Polygon)
+	}
+
+	for _, w := range []Winding{0, 3, -1} {
+		g.Rewind(w)
+		if !IsClockwise(g.Geometries[0].Polygon[0]) || !IsCounterClockwise(g.Geometries[0].Polygon[1]) {
+			t.Errorf("should not change rings for unknown winding %v", w)
+		}
+	}
+
+	// nil geometries are ignored
+	var nilGeometry *Geometry
+	nilGeometry.Rewind(WindingRFC7946)
+	NewCollectionGeometry(nil).Rewind(WindingRFC7946)
+}